@@ -0,0 +1,178 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// The scenarios that used to be picked with "1" and "2"
+//
+//go:embed configs/*.json
+var bundledConfigs embed.FS
+
+var bundledConfigNames = map[string]string{
+	"1": "configs/basic.json",
+	"2": "configs/arbitrage.json",
+}
+
+// Used when an exchange does not set its snapshot interval
+const defaultSnapshotInterval = time.Second
+
+// ServerConfig describes every exchange the server runs
+type ServerConfig struct {
+	Exchanges []ExchangeConfig `json:"exchanges"`
+}
+
+type ExchangeConfig struct {
+	Name             string `json:"name"`
+	GrpcAddress      string `json:"grpcAddress"`
+	MulticastAddress string `json:"multicastAddress"`
+	// Tags every packet so consumers can tell exchanges on the same group
+	// apart. Left out it is the exchange's position in the list, counting from 1.
+	Channel *uint64 `json:"channel"`
+	// "marketByPrice", the default, or "marketByOrder"
+	FeedMode string `json:"feedMode"`
+	// Durations such as "1s" or "5m", empty leaves the exchange's default
+	SnapshotInterval        string         `json:"snapshotInterval"`
+	VolatilityAuctionPeriod string         `json:"volatilityAuctionPeriod"`
+	Symbols                 []SymbolConfig `json:"symbols"`
+}
+
+// Zero reference data fields accept any price and quantity, like AddOrderbook
+type SymbolConfig struct {
+	Id          uint64            `json:"id"`
+	Ticker      string            `json:"ticker"`
+	TickSize    uint64            `json:"tickSize"`
+	LotSize     uint64            `json:"lotSize"`
+	MinQuantity uint64            `json:"minQuantity"`
+	MaxQuantity uint64            `json:"maxQuantity"`
+	MaxNotional uint64            `json:"maxNotional"`
+	PriceScale  uint32            `json:"priceScale"`
+	PriceBands  *PriceBandsConfig `json:"priceBands"`
+}
+
+// Band widths are in basis points
+type PriceBandsConfig struct {
+	ReferencePrice uint64 `json:"referencePrice"`
+	StaticBand     uint64 `json:"staticBand"`
+	DynamicCollar  uint64 `json:"dynamicCollar"`
+}
+
+// LoadConfig reads a config file, or one of the bundled scenarios when given
+// its number
+func LoadConfig(path string) (ServerConfig, error) {
+	var data []byte
+	var err error
+	if name, bundled := bundledConfigNames[path]; bundled {
+		data, err = bundledConfigs.ReadFile(name)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return ServerConfig{}, err
+	}
+	return ParseConfig(data)
+}
+
+func ParseConfig(data []byte) (ServerConfig, error) {
+	var config ServerConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return ServerConfig{}, err
+	}
+	if len(config.Exchanges) == 0 {
+		return ServerConfig{}, fmt.Errorf("config has no exchanges")
+	}
+	// Consumers tell exchanges apart by channel, and each serves on its own address
+	channels := make(map[uint64]string)
+	addresses := make(map[string]string)
+	for i, exchangeConfig := range config.Exchanges {
+		if exchangeConfig.Name == "" {
+			config.Exchanges[i].Name = fmt.Sprintf("Exchange %d", i+1)
+		}
+		if exchangeConfig.Channel == nil {
+			channel := uint64(i + 1)
+			config.Exchanges[i].Channel = &channel
+		}
+		name, channel := config.Exchanges[i].Name, *config.Exchanges[i].Channel
+		if exchangeConfig.GrpcAddress == "" {
+			return ServerConfig{}, fmt.Errorf("%s has no gRPC address", name)
+		}
+		if channel == 0 {
+			return ServerConfig{}, fmt.Errorf("%s cannot use channel 0", name)
+		}
+		if other, exists := channels[channel]; exists {
+			return ServerConfig{}, fmt.Errorf("%s and %s both use channel %d", other, name, channel)
+		}
+		if other, exists := addresses[exchangeConfig.GrpcAddress]; exists {
+			return ServerConfig{}, fmt.Errorf("%s and %s both serve on %s", other, name, exchangeConfig.GrpcAddress)
+		}
+		channels[channel] = name
+		addresses[exchangeConfig.GrpcAddress] = name
+	}
+	return config, nil
+}
+
+func (exchangeConfig ExchangeConfig) feedMode() (exg.FeedMode, error) {
+	switch exchangeConfig.FeedMode {
+	case "", "marketByPrice":
+		return exg.MarketByPrice, nil
+	case "marketByOrder":
+		return exg.MarketByOrder, nil
+	}
+	return exg.MarketByPrice, fmt.Errorf("%s has an unknown feed mode %q", exchangeConfig.Name, exchangeConfig.FeedMode)
+}
+
+func (exchangeConfig ExchangeConfig) snapshotInterval() (time.Duration, error) {
+	if exchangeConfig.SnapshotInterval == "" {
+		return defaultSnapshotInterval, nil
+	}
+	return time.ParseDuration(exchangeConfig.SnapshotInterval)
+}
+
+func (exchangeConfig ExchangeConfig) multicastAddr() (*net.UDPAddr, error) {
+	if exchangeConfig.MulticastAddress == "" {
+		return nil, nil
+	}
+	return net.ResolveUDPAddr("udp", exchangeConfig.MulticastAddress)
+}
+
+// NewExchange builds the exchange and its order books, it does not listen on
+// or dial anything
+func (exchangeConfig ExchangeConfig) NewExchange() (*exg.Exchange, error) {
+	exchange := exg.NewExchange()
+	mode, err := exchangeConfig.feedMode()
+	if err != nil {
+		return nil, err
+	}
+	exchange.SetFeedMode(mode)
+	if exchangeConfig.VolatilityAuctionPeriod != "" {
+		period, err := time.ParseDuration(exchangeConfig.VolatilityAuctionPeriod)
+		if err != nil {
+			return nil, err
+		}
+		exchange.SetVolatilityAuctionPeriod(period)
+	}
+
+	for _, symbol := range exchangeConfig.Symbols {
+		referenceData, err := ob.NewReferenceData(symbol.TickSize, symbol.LotSize, symbol.MinQuantity, symbol.MaxQuantity, symbol.MaxNotional, symbol.PriceScale)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %v", exchangeConfig.Name, symbol.Ticker, err)
+		}
+		if err := exchange.AddOrderbookWithReferenceData(symbol.Id, symbol.Ticker, referenceData); err != nil {
+			return nil, fmt.Errorf("%s %s: %v", exchangeConfig.Name, symbol.Ticker, err)
+		}
+		if bands := symbol.PriceBands; bands != nil {
+			if err := exchange.SetPriceBands(symbol.Id, ob.NewPriceBands(bands.ReferencePrice, bands.StaticBand, bands.DynamicCollar)); err != nil {
+				return nil, fmt.Errorf("%s %s: %v", exchangeConfig.Name, symbol.Ticker, err)
+			}
+		}
+	}
+	return exchange, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBundledConfigs(t *testing.T) {
+	for name, exchanges := range map[string]int{"1": 1, "2": 2} {
+		config, err := LoadConfig(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(config.Exchanges) != exchanges {
+			t.Fatalf("config %s has %d exchanges, want %d", name, len(config.Exchanges), exchanges)
+		}
+		for _, exchangeConfig := range config.Exchanges {
+			if _, err := newExchangeServer(exchangeConfig); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestConfigReferenceData(t *testing.T) {
+	config, err := ParseConfig([]byte(`{
+		"exchanges": [{
+			"grpcAddress": ":9000",
+			"feedMode": "marketByOrder",
+			"symbols": [{
+				"id": 3, "ticker": "ES", "tickSize": 25, "lotSize": 1, "maxQuantity": 500, "priceScale": 2,
+				"priceBands": {"referencePrice": 500000, "staticBand": 1000}
+			}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exchangeConfig := config.Exchanges[0]; exchangeConfig.Name != "Exchange 1" || *exchangeConfig.Channel != 1 {
+		t.Errorf("expected the name and channel to default from the position, got %q and %d", exchangeConfig.Name, *exchangeConfig.Channel)
+	}
+	exchange, err := config.Exchanges[0].NewExchange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state := exchange.GetOrderBookState(3); state == nil || state.PriceScale != 2 {
+		t.Fatalf("expected the book to be added with a price scale of 2, got %v", state)
+	}
+	// Off the 25 tick
+	if _, err := exchange.HandleOrder(context.Background(), &exg.OrderMessage{SymbolId: 3, Id: 1, OrderSide: exg.Side_BID, Price: 500010, Quantity: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the tick size to be applied, got %v", err)
+	}
+	// Outside the 10% static band
+	if _, err := exchange.HandleOrder(context.Background(), &exg.OrderMessage{SymbolId: 3, Id: 2, OrderSide: exg.Side_BID, Price: 600000, Quantity: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected the price bands to be applied, got %v", err)
+	}
+}
+
+func TestInvalidConfigs(t *testing.T) {
+	for name, data := range map[string]string{
+		"no exchanges": `{"exchanges": []}`,
+		"no address":   `{"exchanges": [{"symbols": [{"id": 0, "ticker": "SPY"}]}]}`,
+		"not json":     `exchanges:`,
+		// The first exchange defaults to channel 1
+		"duplicate channel": `{"exchanges": [{"grpcAddress": ":9000"}, {"grpcAddress": ":9001", "channel": 1}]}`,
+		"duplicate address": `{"exchanges": [{"grpcAddress": ":9000"}, {"grpcAddress": ":9000"}]}`,
+		// Only a missing channel is defaulted
+		"channel 0": `{"exchanges": [{"grpcAddress": ":9000", "channel": 0}]}`,
+	} {
+		if _, err := ParseConfig([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	for name, data := range map[string]string{
+		"duplicate symbol": `{"exchanges": [{"grpcAddress": ":9000", "symbols": [{"id": 0, "ticker": "SPY"}, {"id": 0, "ticker": "QQQ"}]}]}`,
+		"min above max":    `{"exchanges": [{"grpcAddress": ":9000", "symbols": [{"id": 0, "ticker": "SPY", "minQuantity": 10, "maxQuantity": 5}]}]}`,
+		"unknown feed":     `{"exchanges": [{"grpcAddress": ":9000", "feedMode": "level3"}]}`,
+		"bad interval":     `{"exchanges": [{"grpcAddress": ":9000", "snapshotInterval": "soon"}]}`,
+	} {
+		config, err := ParseConfig([]byte(data))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, err := newExchangeServer(config.Exchanges[0]); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
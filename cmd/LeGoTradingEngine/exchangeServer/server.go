@@ -5,125 +5,95 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
 	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
 	"google.golang.org/grpc"
 )
 
-func main() {
-
-	args := os.Args[1:]
-
-	switch len(args) {
-	case 0:
-		fmt.Println("Please specify the exchange/server simulation you wish to run. 1 = Basic (SPY Orderbook only), 2 = Arbitrage Simulation.")
-	case 1:
-		if args[0] == "1" {
-
-			fmt.Println("Running Basic Exchange. Please run the client side code.")
-
-			exchange := exg.NewExchange()
-			exchange.AddOrderbook(0, "SPY")
-
-			go func() {
-				lis, err := net.Listen("tcp", ":9000")
-				if err != nil {
-					log.Fatalf("Failed to listen on port 9000: %v", err)
-				}
-
-				grpcServer := grpc.NewServer()
-				exg.RegisterExchangeServiceServer(grpcServer, exchange)
-
-				if err := grpcServer.Serve(lis); err != nil {
-					log.Fatalf("Failed to serve gRPC server over port 9000: %v", err)
-				}
-			}()
-
-			// Setup UDP broadcast connection
-			addr := &net.UDPAddr{
-				IP:   net.IPv4(239, 0, 0, 1), // Multicast address
-				Port: 8011,
-			}
-
-			udpConn, err := net.DialUDP("udp", nil, addr)
-			if err != nil {
-				log.Fatalf("Failed to setup UDP broadcast: %v", err)
-			}
-			defer udpConn.Close()
-
-			// Set up UDP broadcaster in the exchange
-			exchange.SetupBroadcaster(udpConn)
-
-			select {}
-
-		} else if args[0] == "2" {
-			fmt.Println("Running Arbitrage Simulation. Please run the client side code.")
-
-			exchange1 := exg.NewExchange()
-			exchange1.AddOrderbook(0, "LEBRON")
-
-			exchange2 := exg.NewExchange()
-			exchange2.AddOrderbook(0, "LEBRON")
-
-			go func() {
-				lis, err := net.Listen("tcp", ":9000")
-				if err != nil {
-					log.Fatalf("Failed to listen on port 9000: %v", err)
-				}
-
-				grpcServer := grpc.NewServer()
-				exg.RegisterExchangeServiceServer(grpcServer, exchange1)
-
-				if err := grpcServer.Serve(lis); err != nil {
-					log.Fatalf("Failed to serve gRPC server over port 9000: %v", err)
-				}
-			}()
+// Everything one configured exchange needs before it starts serving
+type exchangeServer struct {
+	config           ExchangeConfig
+	exchange         *exg.Exchange
+	multicastAddr    *net.UDPAddr
+	snapshotInterval time.Duration
+}
 
-			go func() {
-				lis, err := net.Listen("tcp", ":9001")
-				if err != nil {
-					log.Fatalf("Failed to listen on port 9001: %v", err)
-				}
+func newExchangeServer(config ExchangeConfig) (*exchangeServer, error) {
+	exchange, err := config.NewExchange()
+	if err != nil {
+		return nil, err
+	}
+	multicastAddr, err := config.multicastAddr()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", config.Name, err)
+	}
+	snapshotInterval, err := config.snapshotInterval()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", config.Name, err)
+	}
+	return &exchangeServer{config, exchange, multicastAddr, snapshotInterval}, nil
+}
 
-				grpcServer := grpc.NewServer()
-				exg.RegisterExchangeServiceServer(grpcServer, exchange2)
+func (server *exchangeServer) serve() {
+	lis, err := net.Listen("tcp", server.config.GrpcAddress)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", server.config.GrpcAddress, err)
+	}
 
-				if err := grpcServer.Serve(lis); err != nil {
-					log.Fatalf("Failed to serve gRPC server over port 9001: %v", err)
-				}
-			}()
+	grpcServer := grpc.NewServer()
+	exg.RegisterExchangeServiceServer(grpcServer, server.exchange)
+	exg.RegisterAdminServiceServer(grpcServer, exg.NewAdminServer(server.exchange))
 
-			// Setup UDP broadcast connection
-			addr1 := &net.UDPAddr{
-				IP:   net.IPv4(239, 0, 0, 1), // Multicast address
-				Port: 8011,
-			}
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("Failed to serve gRPC server over %s: %v", server.config.GrpcAddress, err)
+	}
+}
 
-			udpConn1, err := net.DialUDP("udp", nil, addr1)
-			if err != nil {
-				log.Fatalf("Failed to setup UDP broadcast: %v", err)
-			}
-			defer udpConn1.Close()
+// Exchanges without a multicast address only serve gRPC
+func (server *exchangeServer) broadcast() {
+	if server.multicastAddr == nil {
+		return
+	}
+	udpConn, err := net.DialUDP("udp", nil, server.multicastAddr)
+	if err != nil {
+		log.Fatalf("Failed to setup UDP broadcast: %v", err)
+	}
+	server.exchange.SetupBroadcaster(udpConn, *server.config.Channel)
+	go server.exchange.PublishSnapshots(server.snapshotInterval)
+}
 
-			// Setup UDP broadcast connection
-			addr2 := &net.UDPAddr{
-				IP:   net.IPv4(239, 0, 0, 1), // Multicast address
-				Port: 8012,
-			}
+func main() {
 
-			udpConn2, err := net.DialUDP("udp", nil, addr2)
-			if err != nil {
-				log.Fatalf("Failed to setup UDP broadcast: %v", err)
-			}
-			defer udpConn2.Close()
+	args := os.Args[1:]
 
-			exchange1.SetupBroadcaster(udpConn1)
-			exchange2.SetupBroadcaster(udpConn2)
+	if len(args) != 1 {
+		fmt.Println("Please specify the config file of the exchanges you wish to run, or a bundled simulation. 1 = Basic (SPY Orderbook only), 2 = Arbitrage Simulation.")
+		return
+	}
 
-			select {}
+	config, err := LoadConfig(args[0])
+	if err != nil {
+		log.Fatalf("Failed to load config %s: %v", args[0], err)
+	}
 
-		} else {
-			fmt.Println("Please specify a valid exchange/server simulation you wish to run. 1 = Basic (SPY Orderbook only), 2 = Arbitrage Simulation.")
+	// Every exchange is built before any starts so a bad config starts nothing
+	servers := make([]*exchangeServer, 0, len(config.Exchanges))
+	for _, exchangeConfig := range config.Exchanges {
+		server, err := newExchangeServer(exchangeConfig)
+		if err != nil {
+			log.Fatalf("Failed to set up exchange: %v", err)
 		}
+		servers = append(servers, server)
+	}
+
+	for _, server := range servers {
+		fmt.Printf("Running %s on %s with %d orderbooks.\n", server.config.Name, server.config.GrpcAddress, len(server.config.Symbols))
+		go server.serve()
+		server.broadcast()
+		go server.exchange.RunExpirySweeper(time.Second)
 	}
+	fmt.Println("Please run the client side code.")
+
+	select {}
 }
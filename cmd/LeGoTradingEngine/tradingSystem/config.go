@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// The bundled scenarios the exchange server runs with "1" and "2", relative to
+// the repository root
+var bundledConfigPaths = map[string]string{
+	"1": "cmd/LeGoTradingEngine/exchangeServer/configs/basic.json",
+	"2": "cmd/LeGoTradingEngine/exchangeServer/configs/arbitrage.json",
+}
+
+// The parts of the exchange server's config the trading system needs
+type exchangesConfig struct {
+	Exchanges []struct {
+		GrpcAddress string  `json:"grpcAddress"`
+		Channel     *uint64 `json:"channel"`
+	} `json:"exchanges"`
+}
+
+// LoadRecoveryAddresses reads the exchange server's config, or one of its
+// bundled scenarios when given its number, and returns the gRPC address of the
+// exchange publishing on each channel
+func LoadRecoveryAddresses(path string) (map[uint64]string, error) {
+	if bundled, exists := bundledConfigPaths[path]; exists {
+		path = bundled
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecoveryAddresses(data)
+}
+
+// Channels that are left out are numbered the way the server numbers them
+func ParseRecoveryAddresses(data []byte) (map[uint64]string, error) {
+	var config exchangesConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	if len(config.Exchanges) == 0 {
+		return nil, fmt.Errorf("config has no exchanges")
+	}
+	addresses := make(map[uint64]string)
+	for i, exchangeConfig := range config.Exchanges {
+		channel := uint64(i + 1)
+		if exchangeConfig.Channel != nil {
+			channel = *exchangeConfig.Channel
+		}
+		if exchangeConfig.GrpcAddress == "" {
+			return nil, fmt.Errorf("exchange %d has no gRPC address", i+1)
+		}
+		if channel == 0 {
+			return nil, fmt.Errorf("exchange %d cannot use channel 0", i+1)
+		}
+		if _, exists := addresses[channel]; exists {
+			return nil, fmt.Errorf("channel %d is used twice", channel)
+		}
+		addresses[channel] = exchangeConfig.GrpcAddress
+	}
+	return addresses, nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+)
+
+// GapError is returned when the feed skipped sequence numbers, the book is
+// stale from then until the next snapshot
+type GapError struct {
+	Channel  uint64
+	SymbolId uint64
+	Expected uint64
+	Received uint64
+}
+
+func (err *GapError) Error() string {
+	return fmt.Sprintf("gap on channel %d symbol %d: expected sequence number %d, received %d",
+		err.Channel, err.SymbolId, err.Expected, err.Received)
+}
+
+// LocalOrderBook is the aggregator's copy of an exchange book, rebuilt from
+// the incremental market data feed. Market by order feeds are aggregated into
+// levels as they arrive.
+type LocalOrderBook struct {
+	channel           uint64
+	symbolId          uint64
+	bids              map[uint64]uint64
+	asks              map[uint64]uint64
+	lastExecutedPrice uint64
+	sequenceNumber    uint64
+	sessionState      exg.SessionState
+	priceScale        uint32
+	// Set until the first snapshot and after every gap, updates are ignored
+	// while the book is stale
+	stale bool
+}
+
+func NewLocalOrderBook(channel uint64, symbolId uint64) *LocalOrderBook {
+	return &LocalOrderBook{
+		channel:           channel,
+		symbolId:          symbolId,
+		bids:              make(map[uint64]uint64),
+		asks:              make(map[uint64]uint64),
+		lastExecutedPrice: math.MaxUint64,
+		stale:             true,
+	}
+}
+
+// Apply returns false if the message was ignored, either because it is older
+// than the book or because the book is stale. A *GapError is returned along
+// with false when the message shows updates were lost.
+func (book *LocalOrderBook) Apply(message *exg.MarketDataMessage) (bool, error) {
+	sequenceNumber := message.GetHeader().GetSequenceNumber()
+
+	snapshot := message.GetSnapshot()
+	orderSnapshot := message.GetMarketByOrderSnapshot()
+	if snapshot != nil || orderSnapshot != nil {
+		if !book.stale && sequenceNumber < book.sequenceNumber {
+			return false, nil
+		}
+		clear(book.bids)
+		clear(book.asks)
+		if snapshot != nil {
+			for _, level := range snapshot.Bids {
+				book.bids[level.Price] = level.Quantity
+			}
+			for _, level := range snapshot.Asks {
+				book.asks[level.Price] = level.Quantity
+			}
+			book.lastExecutedPrice = snapshot.LastExecutedPrice
+			book.sessionState = snapshot.SessionState
+			book.priceScale = snapshot.PriceScale
+		} else {
+			for _, order := range orderSnapshot.Bids {
+				book.bids[order.Price] += order.Quantity
+			}
+			for _, order := range orderSnapshot.Asks {
+				book.asks[order.Price] += order.Quantity
+			}
+			book.lastExecutedPrice = orderSnapshot.LastExecutedPrice
+			book.sessionState = orderSnapshot.SessionState
+			book.priceScale = orderSnapshot.PriceScale
+		}
+		book.sequenceNumber = sequenceNumber
+		book.stale = false
+		return true, nil
+	}
+
+	// Duplicates and reordered packets we have already moved past
+	if book.stale || sequenceNumber <= book.sequenceNumber {
+		return false, nil
+	}
+	if sequenceNumber != book.sequenceNumber+1 {
+		book.stale = true
+		return false, &GapError{
+			Channel:  book.channel,
+			SymbolId: book.symbolId,
+			Expected: book.sequenceNumber + 1,
+			Received: sequenceNumber,
+		}
+	}
+	book.sequenceNumber = sequenceNumber
+
+	if update := message.GetLevelUpdate(); update != nil {
+		levels := book.bids
+		if update.Side == exg.Side_ASK {
+			levels = book.asks
+		}
+		if update.Action == exg.LevelUpdateAction_LEVEL_DELETE {
+			delete(levels, update.Price)
+		} else {
+			levels[update.Price] = update.Quantity
+		}
+	}
+	if update := message.GetOrderUpdate(); update != nil {
+		levels := book.bids
+		if update.Side == exg.Side_ASK {
+			levels = book.asks
+		}
+		if update.Action == exg.OrderUpdateAction_ORDER_ADD {
+			levels[update.Price] += update.Quantity
+		} else if levels[update.Price] <= update.Quantity {
+			delete(levels, update.Price)
+		} else {
+			levels[update.Price] -= update.Quantity
+		}
+	}
+	if trade := message.GetTrade(); trade != nil {
+		book.lastExecutedPrice = trade.Price
+	}
+	if status := message.GetSessionStatus(); status != nil {
+		book.sessionState = status.State
+	}
+	return true, nil
+}
+
+// Resume applies retransmitted messages to a book that went stale because of a
+// gap, the book is only live again if they carry on exactly where it stopped
+func (book *LocalOrderBook) Resume(messages []*exg.MarketDataMessage) error {
+	book.stale = false
+	for _, message := range messages {
+		if _, err := book.Apply(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (book *LocalOrderBook) IsStale() bool {
+	return book.stale
+}
+
+func (book *LocalOrderBook) GetSessionState() exg.SessionState {
+	return book.sessionState
+}
+
+// Halted books only accept cancels until the exchange resumes them
+func (book *LocalOrderBook) IsHalted() bool {
+	return book.sessionState == exg.SessionState_SESSION_HALTED
+}
+
+// State renders the top depth levels the same way the exchange does
+func (book *LocalOrderBook) State(depth int) *exg.OrderBookState {
+	state := &exg.OrderBookState{
+		Bids:              topLevels(book.bids, depth, func(a, b uint64) bool { return a > b }),
+		Asks:              topLevels(book.asks, depth, func(a, b uint64) bool { return a < b }),
+		LastExecutedPrice: book.lastExecutedPrice,
+		BestAsk:           math.MaxUint64,
+		Stale:             book.stale,
+		SessionState:      book.sessionState,
+		PriceScale:        book.priceScale,
+	}
+	if len(state.Bids) > 0 {
+		state.BestBid = state.Bids[0].Price
+	}
+	if len(state.Asks) > 0 {
+		state.BestAsk = state.Asks[0].Price
+	}
+	state.Spread = state.BestAsk - state.BestBid
+	return state
+}
+
+func topLevels(levels map[uint64]uint64, depth int, better func(a, b uint64) bool) []*exg.Level {
+	prices := make([]uint64, 0, len(levels))
+	for price := range levels {
+		prices = append(prices, price)
+	}
+	sort.Slice(prices, func(i, j int) bool { return better(prices[i], prices[j]) })
+	if len(prices) > depth {
+		prices = prices[:depth]
+	}
+
+	top := make([]*exg.Level, 0, len(prices))
+	for _, price := range prices {
+		top = append(top, &exg.Level{Price: price, Quantity: levels[price]})
+	}
+	return top
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+)
+
+// Rebuilds a book from a snapshot and the deltas after it and checks it
+// matches the exchange's own view
+func TestLocalOrderBookRebuild(t *testing.T) {
+	exchange := exg.NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	handleOrders := func(orders ...*exg.OrderMessage) {
+		t.Helper()
+		for _, order := range orders {
+			if _, err := exchange.HandleOrder(ctx, order); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	handleOrders(
+		&exg.OrderMessage{Id: 1, OrderSide: exg.Side_BID, Price: 99, Quantity: 10},
+		&exg.OrderMessage{Id: 2, OrderSide: exg.Side_ASK, Price: 102, Quantity: 10},
+	)
+
+	snapshot, err := exchange.GetSnapshot(ctx, &exg.SnapshotRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := NewLocalOrderBook(1, 0)
+	if applied, err := book.Apply(snapshot); !applied || err != nil || book.IsStale() {
+		t.Fatalf("expected the snapshot to bring the book live, got %v and %v", applied, err)
+	}
+
+	handleOrders(
+		&exg.OrderMessage{Id: 3, OrderSide: exg.Side_BID, Price: 100, Quantity: 5},
+		&exg.OrderMessage{Id: 4, OrderSide: exg.Side_BID, Price: 99, Quantity: 7},
+		&exg.OrderMessage{Id: 5, OrderSide: exg.Side_ASK, Price: 101, Quantity: 8},
+		// Sweeps 100 and part of 99
+		&exg.OrderMessage{Id: 6, OrderSide: exg.Side_ASK, Price: 99, Quantity: 12},
+		&exg.OrderMessage{Command: exg.Command_DELETE, Id: 2},
+		&exg.OrderMessage{Command: exg.Command_MODIFY, Id: 5, Price: 101, Quantity: 3},
+	)
+	end := exchange.GetOrderBookState(0)
+	last, err := exchange.GetSnapshot(ctx, &exg.SnapshotRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := exchange.Retransmit(ctx, &exg.RetransmitRequest{
+		SymbolId:           0,
+		FromSequenceNumber: snapshot.Header.SequenceNumber + 1,
+		ToSequenceNumber:   last.Header.SequenceNumber,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, message := range response.Messages {
+		if applied, err := book.Apply(message); !applied || err != nil {
+			t.Fatalf("message %v was not applied: %v", message, err)
+		}
+	}
+
+	state := book.State(10)
+	if state.LastExecutedPrice != end.LastExecutedPrice || state.BestBid != end.BestBid || state.BestAsk != end.BestAsk {
+		t.Errorf("expected\n%s\ngot\n%s", end.ObsToString(), state.ObsToString())
+	}
+	for _, sides := range [][2][]*exg.Level{{state.Bids, end.Bids}, {state.Asks, end.Asks}} {
+		local, exchangeLevels := sides[0], sides[1]
+		if len(local) != len(exchangeLevels) {
+			t.Fatalf("expected\n%s\ngot\n%s", end.ObsToString(), state.ObsToString())
+		}
+		for i := range local {
+			if local[i].Price != exchangeLevels[i].Price || local[i].Quantity != exchangeLevels[i].Quantity {
+				t.Fatalf("expected\n%s\ngot\n%s", end.ObsToString(), state.ObsToString())
+			}
+		}
+	}
+}
+
+func TestLocalOrderBookGaps(t *testing.T) {
+	levelUpdate := func(sequenceNumber uint64, quantity uint64) *exg.MarketDataMessage {
+		return &exg.MarketDataMessage{
+			Header: &exg.PacketHeader{Channel: 2, SymbolId: 3, SequenceNumber: sequenceNumber},
+			Body:   &exg.MarketDataMessage_LevelUpdate{LevelUpdate: &exg.LevelUpdate{Action: exg.LevelUpdateAction_LEVEL_MODIFY, Side: exg.Side_BID, Price: 100, Quantity: quantity}},
+		}
+	}
+	snapshot := func(sequenceNumber uint64, quantity uint64) *exg.MarketDataMessage {
+		return &exg.MarketDataMessage{
+			Header: &exg.PacketHeader{Channel: 2, SymbolId: 3, SequenceNumber: sequenceNumber},
+			Body:   &exg.MarketDataMessage_Snapshot{Snapshot: &exg.OrderBookState{Bids: []*exg.Level{{Price: 100, Quantity: quantity}}}},
+		}
+	}
+	book := NewLocalOrderBook(2, 3)
+	expectBid := func(step string, quantity uint64, stale bool) {
+		t.Helper()
+		state := book.State(1)
+		if book.IsStale() != stale || state.Stale != stale || len(state.Bids) != 1 || state.Bids[0].Quantity != quantity {
+			t.Fatalf("%s: expected %d bid at 100 with stale %v, got\n%s", step, quantity, stale, state.ObsToString())
+		}
+	}
+
+	if applied, err := book.Apply(levelUpdate(1, 10)); applied || err != nil || !book.IsStale() {
+		t.Fatalf("expected updates before the first snapshot to be ignored, got %v and %v", applied, err)
+	}
+	if applied, err := book.Apply(snapshot(5, 10)); !applied || err != nil {
+		t.Fatalf("expected the snapshot to be applied, got %v and %v", applied, err)
+	}
+	expectBid("snapshot", 10, false)
+	if applied, err := book.Apply(levelUpdate(6, 20)); !applied || err != nil {
+		t.Fatalf("expected the next update to be applied, got %v and %v", applied, err)
+	}
+	for _, sequenceNumber := range []uint64{4, 5, 6} {
+		if applied, err := book.Apply(levelUpdate(sequenceNumber, 99)); applied || err != nil {
+			t.Fatalf("expected old update %d to be ignored, got %v and %v", sequenceNumber, applied, err)
+		}
+	}
+	if applied, err := book.Apply(snapshot(4, 99)); applied || err != nil {
+		t.Fatalf("expected an old snapshot to be ignored, got %v and %v", applied, err)
+	}
+	expectBid("duplicates", 20, false)
+
+	// 7 is lost
+	applied, err := book.Apply(levelUpdate(8, 40))
+	gap, isGap := err.(*GapError)
+	if applied || !isGap {
+		t.Fatalf("expected a gap, got %v and %v", applied, err)
+	}
+	if *gap != (GapError{Channel: 2, SymbolId: 3, Expected: 7, Received: 8}) {
+		t.Errorf("gap reported as %v", gap)
+	}
+	if applied, err := book.Apply(levelUpdate(7, 30)); applied || err != nil {
+		t.Fatalf("expected updates to be ignored while stale, got %v and %v", applied, err)
+	}
+	expectBid("gap", 20, true)
+
+	// Retransmission fills the gap
+	if err := book.Resume([]*exg.MarketDataMessage{levelUpdate(7, 30), levelUpdate(8, 40)}); err != nil {
+		t.Fatal(err)
+	}
+	expectBid("resumed", 40, false)
+
+	// Retransmissions that do not carry on from the book leave it stale
+	if _, err := book.Apply(levelUpdate(10, 60)); err == nil {
+		t.Fatal("expected a second gap")
+	}
+	if err := book.Resume([]*exg.MarketDataMessage{levelUpdate(10, 60)}); err == nil || !book.IsStale() {
+		t.Fatalf("expected resuming after 9 to fail, got %v", err)
+	}
+
+	// Only a snapshot brings it back otherwise, even one older than the book
+	if applied, err := book.Apply(snapshot(8, 45)); !applied || err != nil {
+		t.Fatalf("expected the snapshot to be applied, got %v and %v", applied, err)
+	}
+	expectBid("resynced", 45, false)
+}
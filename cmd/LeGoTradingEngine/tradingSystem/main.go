@@ -3,8 +3,11 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func displayOrderBook(state *exg.OrderBookState) {
@@ -12,12 +15,32 @@ func displayOrderBook(state *exg.OrderBookState) {
 }
 
 func main() {
+	args := os.Args[1:]
+	if len(args) != 1 {
+		fmt.Println("Please specify the config file the exchange server was started with, or the number of its bundled simulation.")
+		return
+	}
+	addresses, err := LoadRecoveryAddresses(args[0])
+	if err != nil {
+		log.Fatalf("Failed to load config %s: %v", args[0], err)
+	}
+
 	mda, err := NewBasicMarketDataAggregator(8011)
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
 	defer mda.Close()
 
+	// Gaps on each channel are recovered from the exchange that publishes it
+	for channel, address := range addresses {
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to create recovery client for channel %d: %v", channel, err)
+		}
+		defer conn.Close()
+		mda.SetRecoveryClient(channel, exg.NewExchangeServiceClient(conn))
+	}
+
 	if err := mda.ListenForUpdates(); err != nil {
 		log.Fatalf("Subscription error: %v", err)
 	}
@@ -103,10 +103,22 @@ func NewMultiMarketDataAggregator(port1 int, port2 int) (*MultiMarketDataAggrega
 	}, nil
 }
 
+// Books are identified by the channel they arrive on as well as their symbol,
+// two exchanges can list the same symbol id
+type bookKey struct {
+	channel  uint64
+	symbolId uint64
+}
+
 type BasicMarketDataAggregator struct {
 	udpConn *net.UDPConn
 	shm     *SharedMemory
 	queue   *SharedSPMCQueue
+	books   map[bookKey]*LocalOrderBook
+	// Optional, used to heal stale books without waiting for a multicast
+	// snapshot. Each channel is recovered from the exchange that publishes it.
+	recovery         map[uint64]exg.ExchangeServiceClient
+	recoveryFailedAt map[bookKey]time.Time
 }
 
 func NewBasicMarketDataAggregator(port int) (*BasicMarketDataAggregator, error) {
@@ -154,12 +166,19 @@ func NewBasicMarketDataAggregator(port int) (*BasicMarketDataAggregator, error)
 	}
 
 	return &BasicMarketDataAggregator{
-		udpConn: udpconn,
-		shm:     shm,
-		queue:   sharedSPMCqueue,
+		udpConn:          udpconn,
+		shm:              shm,
+		queue:            sharedSPMCqueue,
+		books:            make(map[bookKey]*LocalOrderBook),
+		recovery:         make(map[uint64]exg.ExchangeServiceClient),
+		recoveryFailedAt: make(map[bookKey]time.Time),
 	}, nil
 }
 
+func (mda *BasicMarketDataAggregator) SetRecoveryClient(channel uint64, client exg.ExchangeServiceClient) {
+	mda.recovery[channel] = client
+}
+
 func (mda *BasicMarketDataAggregator) Close() {
 	if mda.udpConn != nil {
 		mda.udpConn.Close()
@@ -171,7 +190,7 @@ func (mda *BasicMarketDataAggregator) Close() {
 
 func (mda *BasicMarketDataAggregator) ListenForUpdates() error {
 	buffer := make([]byte, 65536)
-	state := &exg.OrderBookState{}
+	message := &exg.MarketDataMessage{}
 
 	log.Println("Starting to listen for orderbook updates...")
 	for {
@@ -185,14 +204,20 @@ func (mda *BasicMarketDataAggregator) ListenForUpdates() error {
 
 		receiveTime := time.Now().UnixNano()
 
-		state.Reset()
-		err = proto.Unmarshal(buffer[:n], state)
+		message.Reset()
+		err = proto.Unmarshal(buffer[:n], message)
 		if err != nil {
-			log.Printf("Error unmarshaling state: %v", err)
+			log.Printf("Error unmarshaling market data: %v", err)
 			log.Printf("This might not be a protobuf message, raw content: %s", string(buffer[:n]))
 			continue
 		}
 
+		state, updated := mda.handleMessage(message)
+		if !updated {
+			continue
+		}
+		state.Timestamp = message.Timestamp
+
 		// Write to shared memory
 		data, err := proto.Marshal(state)
 		if err != nil {
@@ -206,7 +231,7 @@ func (mda *BasicMarketDataAggregator) ListenForUpdates() error {
 
 		mda.queue.Write(data)
 
-		latencyNs := receiveTime - state.Timestamp
+		latencyNs := receiveTime - message.Timestamp
 		latencyMs := float64(latencyNs) / 1_000_000 // Convert to milliseconds
 
 		log.Printf("Latency: %.3f ms", latencyMs)
@@ -214,6 +239,35 @@ func (mda *BasicMarketDataAggregator) ListenForUpdates() error {
 	}
 }
 
+// Applies one feed message to its book and returns the book's new top levels,
+// false if nothing changed
+func (mda *BasicMarketDataAggregator) handleMessage(message *exg.MarketDataMessage) (*exg.OrderBookState, bool) {
+	header := message.GetHeader()
+	key := bookKey{channel: header.GetChannel(), symbolId: header.GetSymbolId()}
+	book, exists := mda.books[key]
+	if !exists {
+		book = NewLocalOrderBook(key.channel, key.symbolId)
+		mda.books[key] = book
+	}
+
+	sessionState := book.GetSessionState()
+	applied, err := book.Apply(message)
+	if err != nil {
+		log.Printf("Market data %v", err)
+	}
+	if book.GetSessionState() != sessionState {
+		log.Printf("Channel %d symbol %d is now %v", key.channel, key.symbolId, book.GetSessionState())
+	}
+	if book.IsStale() && mda.recoverBook(key, book, err, message) {
+		applied = true
+	}
+	// A book that just went stale is still published so consumers find out
+	if !applied && err == nil {
+		return nil, false
+	}
+	return book.State(10), true
+}
+
 func (mda *BasicMarketDataAggregator) Subscribe() {
 	mda.queue.RegisterConsumer()
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+)
+
+const (
+	recoveryTimeout = 500 * time.Millisecond
+	// After a failed recovery the book waits this long before asking again,
+	// multicast snapshots can still heal it in the meantime
+	recoveryBackoff = time.Second
+)
+
+// Heals a stale book over gRPC. A gap is filled by retransmission of the
+// missing messages, anything else, or a gap the exchange no longer has the
+// messages for, is healed with a fresh snapshot. Returns true if the book is
+// usable again.
+func (mda *BasicMarketDataAggregator) recoverBook(key bookKey, book *LocalOrderBook, applyErr error, message *exg.MarketDataMessage) bool {
+	recovery := mda.recovery[key.channel]
+	if recovery == nil || time.Since(mda.recoveryFailedAt[key]) < recoveryBackoff {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), recoveryTimeout)
+	defer cancel()
+
+	var gap *GapError
+	if errors.As(applyErr, &gap) {
+		// The message that showed the gap is resent along with the missing ones
+		response, err := recovery.Retransmit(ctx, &exg.RetransmitRequest{
+			SymbolId:           key.symbolId,
+			FromSequenceNumber: gap.Expected,
+			ToSequenceNumber:   gap.Received,
+		})
+		if err == nil {
+			err = book.Resume(response.Messages)
+		}
+		if err == nil {
+			log.Printf("Recovered sequence numbers %d to %d on channel %d symbol %d", gap.Expected, gap.Received, key.channel, key.symbolId)
+			return true
+		}
+		log.Printf("Retransmission failed, requesting a snapshot: %v", err)
+	}
+
+	snapshot, err := recovery.GetSnapshot(ctx, &exg.SnapshotRequest{SymbolId: key.symbolId})
+	if err != nil {
+		log.Printf("Snapshot request failed for channel %d symbol %d: %v", key.channel, key.symbolId, err)
+		mda.recoveryFailedAt[key] = time.Now()
+		return false
+	}
+	book.Apply(snapshot)
+	// The message we were handling may be newer than the snapshot
+	book.Apply(message)
+	if book.IsStale() {
+		mda.recoveryFailedAt[key] = time.Now()
+		return false
+	}
+	log.Printf("Recovered channel %d symbol %d from a snapshot at sequence number %d", key.channel, key.symbolId, snapshot.GetHeader().GetSequenceNumber())
+	return true
+}
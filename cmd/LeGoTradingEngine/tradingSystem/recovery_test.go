@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	exg "github.com/Heian0/LeGoTradingEngine/internal/exchange"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+// Counts retransmissions so the test knows gaps were healed by them
+type countingRecoveryClient struct {
+	exg.ExchangeServiceClient
+	retransmits int
+}
+
+func (client *countingRecoveryClient) Retransmit(ctx context.Context, in *exg.RetransmitRequest, opts ...grpc.CallOption) (*exg.RetransmitResponse, error) {
+	client.retransmits++
+	return client.ExchangeServiceClient.Retransmit(ctx, in, opts...)
+}
+
+// Drops a few multicast packets on purpose and checks the aggregator heals the
+// book through the recovery RPCs alone, no multicast snapshots are published
+func TestRecoverDroppedPackets(t *testing.T) {
+	exchange := exg.NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	exg.RegisterExchangeServiceServer(grpcServer, exchange)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	feedListener, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	feedListener.SetReadBuffer(1 << 22)
+	feedConn, err := net.DialUDP("udp", nil, feedListener.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer feedConn.Close()
+	exchange.SetupBroadcaster(feedConn, 1)
+
+	mda := &BasicMarketDataAggregator{
+		books:            make(map[bookKey]*LocalOrderBook),
+		recovery:         make(map[uint64]exg.ExchangeServiceClient),
+		recoveryFailedAt: make(map[bookKey]time.Time),
+	}
+	recovery := &countingRecoveryClient{ExchangeServiceClient: exg.NewExchangeServiceClient(conn)}
+	mda.SetRecoveryClient(1, recovery)
+
+	// Packets are read off the socket as fast as possible so the kernel never
+	// drops any, the only losses are the ones made here
+	packets := make(chan []byte, 4096)
+	go func() {
+		defer close(packets)
+		buffer := make([]byte, 65536)
+		for {
+			n, _, err := feedListener.ReadFromUDP(buffer)
+			if err != nil {
+				return
+			}
+			packets <- append([]byte(nil), buffer[:n]...)
+		}
+	}()
+
+	dropped := map[int]bool{10: true, 60: true, 61: true, 62: true, 200: true}
+	done := make(chan int)
+	go func() {
+		received := 0
+		for packet := range packets {
+			received++
+			if dropped[received] {
+				continue
+			}
+			message := &exg.MarketDataMessage{}
+			if err := proto.Unmarshal(packet, message); err != nil {
+				t.Error(err)
+				continue
+			}
+			mda.handleMessage(message)
+		}
+		done <- received
+	}()
+
+	random := rand.New(rand.NewSource(1))
+	for id := uint64(1); id <= 300; id++ {
+		order := &exg.OrderMessage{
+			Id:        id,
+			OrderSide: exg.Side(random.Intn(2)),
+			Price:     uint64(95 + random.Intn(10)),
+			Quantity:  uint64(1 + random.Intn(20)),
+		}
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+		// Give the aggregator time to sync from a snapshot before the first drop,
+		// then keep the socket from overflowing
+		if id == 1 {
+			time.Sleep(50 * time.Millisecond)
+		} else if id%10 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	feedListener.Close()
+	if received := <-done; received < 200 {
+		t.Fatalf("only %d packets were received, not every drop was exercised", received)
+	}
+
+	// The burst of three drops is healed by a single retransmission
+	if recovery.retransmits != 3 {
+		t.Fatalf("expected 3 retransmissions, got %d", recovery.retransmits)
+	}
+	book := mda.books[bookKey{channel: 1, symbolId: 0}]
+	if book == nil || book.IsStale() {
+		t.Fatal("book did not recover")
+	}
+	want := exchange.GetOrderBookState(0)
+	want.Timestamp = 0
+	if got := book.State(10); !proto.Equal(want, got) {
+		t.Fatalf("recovered book does not match the exchange\nwant %v\ngot  %v", want, got)
+	}
+}
+
+func TestRecoveryAddresses(t *testing.T) {
+	addresses, err := LoadRecoveryAddresses("../exchangeServer/configs/arbitrage.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) != 2 || addresses[1] != ":9000" || addresses[2] != ":9001" {
+		t.Errorf("expected channels 1 and 2 on :9000 and :9001, got %v", addresses)
+	}
+
+	// Channels left out are numbered like the server numbers them
+	addresses, err = ParseRecoveryAddresses([]byte(`{"exchanges": [{"grpcAddress": ":9000"}, {"grpcAddress": ":9001", "channel": 5}, {"grpcAddress": ":9002"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) != 3 || addresses[1] != ":9000" || addresses[5] != ":9001" || addresses[3] != ":9002" {
+		t.Errorf("expected channels 1, 5 and 3, got %v", addresses)
+	}
+
+	for _, config := range []string{
+		`{"exchanges": []}`,
+		`{"exchanges": [{"channel": 1}]}`,
+		`{"exchanges": [{"grpcAddress": ":9000", "channel": 0}]}`,
+		`{"exchanges": [{"grpcAddress": ":9000"}, {"grpcAddress": ":9001", "channel": 1}]}`,
+	} {
+		if _, err := ParseRecoveryAddresses([]byte(config)); err == nil {
+			t.Errorf("expected %s to be rejected", config)
+		}
+	}
+}
@@ -6,13 +6,13 @@ require (
 	gioui.org v0.7.1
 	github.com/emirpasic/gods v1.18.1
 	github.com/golang/protobuf v1.5.4
+	github.com/google/uuid v1.6.0
+	google.golang.org/protobuf v1.34.2
 )
 
 require (
-	github.com/google/uuid v1.6.0 // indirect
 	golang.org/x/net v0.29.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
 )
 
 require (
@@ -0,0 +1,121 @@
+package exchange
+
+import (
+	"context"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// AdminServer serves the AdminService for one exchange. It is its own type as
+// the exchange's Go API already uses the method names.
+type AdminServer struct {
+	UnimplementedAdminServiceServer
+	exchange *Exchange
+}
+
+func NewAdminServer(exchange *Exchange) *AdminServer {
+	return &AdminServer{exchange: exchange}
+}
+
+// SetSessionState implements AdminServiceServer.
+func (server *AdminServer) SetSessionState(ctx context.Context, req *SessionStateRequest) (*SessionStatus, error) {
+	state, err := protoToObEnumSessionState(req.State)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if _, err := server.exchange.SetSessionState(req.SymbolId, state); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &SessionStatus{State: req.State}, nil
+}
+
+// GetSessionState implements AdminServiceServer.
+func (server *AdminServer) GetSessionState(ctx context.Context, req *SymbolRequest) (*SessionStatus, error) {
+	state, err := server.exchange.GetSessionState(req.SymbolId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &SessionStatus{State: obToProtoSessionState(state)}, nil
+}
+
+// ListSymbols implements AdminServiceServer.
+func (server *AdminServer) ListSymbols(ctx context.Context, req *ListSymbolsRequest) (*SymbolList, error) {
+	list := &SymbolList{}
+	for _, symbol := range server.exchange.GetSymbols() {
+		list.Symbols = append(list.Symbols, newSymbolInfo(symbol))
+	}
+	return list, nil
+}
+
+// AddOrderBook implements AdminServiceServer.
+func (server *AdminServer) AddOrderBook(ctx context.Context, req *SymbolInfo) (*SymbolInfo, error) {
+	referenceData, err := ob.NewReferenceData(req.TickSize, req.LotSize, req.MinQuantity, req.MaxQuantity, req.MaxNotional, req.PriceScale)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := server.exchange.AddOrderbookWithReferenceData(req.SymbolId, req.Ticker, referenceData); err != nil {
+		return nil, toStatusError(err)
+	}
+	return newSymbolInfo(ob.NewSymbolWithReferenceData(req.SymbolId, req.Ticker, referenceData)), nil
+}
+
+// DeleteOrderBook implements AdminServiceServer.
+func (server *AdminServer) DeleteOrderBook(ctx context.Context, req *SymbolRequest) (*MassCancelResponse, error) {
+	cancelled, err := server.exchange.DeleteOrderbook(req.SymbolId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &MassCancelResponse{Reports: cancellationReports(cancelled)}, nil
+}
+
+// HaltSymbol implements AdminServiceServer.
+func (server *AdminServer) HaltSymbol(ctx context.Context, req *SymbolRequest) (*SessionStatus, error) {
+	return server.SetSessionState(ctx, &SessionStateRequest{SymbolId: req.SymbolId, State: SessionState_SESSION_HALTED})
+}
+
+// ResumeSymbol implements AdminServiceServer. Use SetSessionState to reopen
+// through an auction instead.
+func (server *AdminServer) ResumeSymbol(ctx context.Context, req *SymbolRequest) (*SessionStatus, error) {
+	return server.SetSessionState(ctx, &SessionStateRequest{SymbolId: req.SymbolId, State: SessionState_SESSION_CONTINUOUS})
+}
+
+// MassCancel implements AdminServiceServer.
+func (server *AdminServer) MassCancel(ctx context.Context, req *MassCancelRequest) (*MassCancelResponse, error) {
+	scope, err := protoToObMassCancelScope(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	cancelled, err := server.exchange.MassCancel(scope)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &MassCancelResponse{Reports: cancellationReports(cancelled)}, nil
+}
+
+// DumpOrderBook implements AdminServiceServer.
+func (server *AdminServer) DumpOrderBook(ctx context.Context, req *SymbolRequest) (*OrderBookDump, error) {
+	symbol, err := server.exchange.GetSymbol(req.SymbolId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	book, err := server.exchange.DumpOrderbook(req.SymbolId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &OrderBookDump{SymbolId: req.SymbolId, Ticker: symbol.GetTicker(), Book: book}, nil
+}
+
+func newSymbolInfo(symbol ob.Symbol) *SymbolInfo {
+	referenceData := symbol.GetReferenceData()
+	return &SymbolInfo{
+		SymbolId:     symbol.GetSymbolId(),
+		Ticker:       symbol.GetTicker(),
+		SessionState: obToProtoSessionState(symbol.GetSessionState()),
+		TickSize:     referenceData.GetTickSize(),
+		LotSize:      referenceData.GetLotSize(),
+		MinQuantity:  referenceData.GetMinQuantity(),
+		MaxQuantity:  referenceData.GetMaxQuantity(),
+		MaxNotional:  referenceData.GetMaxNotional(),
+		PriceScale:   referenceData.GetPriceScale(),
+	}
+}
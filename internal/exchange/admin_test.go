@@ -0,0 +1,119 @@
+package exchange
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminSymbolManagement(t *testing.T) {
+	exchange := NewExchange()
+	admin := NewAdminServer(exchange)
+	ctx := context.Background()
+	for _, symbol := range []*SymbolInfo{
+		{SymbolId: 1, Ticker: "QQQ"},
+		{SymbolId: 0, Ticker: "SPY", TickSize: 5, PriceScale: 2},
+	} {
+		if _, err := admin.AddOrderBook(ctx, symbol); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := admin.AddOrderBook(ctx, &SymbolInfo{SymbolId: 1, Ticker: "IWM"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a duplicate symbol to be refused, got %v", err)
+	}
+	list, err := admin.ListSymbols(ctx, &ListSymbolsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Symbols) != 2 || list.Symbols[0].Ticker != "SPY" || list.Symbols[0].TickSize != 5 || list.Symbols[1].Ticker != "QQQ" {
+		t.Fatalf("expected SPY then QQQ, got %v", list.Symbols)
+	}
+
+	if _, err := admin.HaltSymbol(ctx, &SymbolRequest{SymbolId: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exchange.HandleOrder(ctx, &OrderMessage{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected an add to be refused while halted, got %v", err)
+	}
+	if _, err := admin.ResumeSymbol(ctx, &SymbolRequest{SymbolId: 0}); err != nil {
+		t.Fatal(err)
+	}
+	// Stops only rest once the book has traded
+	for _, order := range []*OrderMessage{
+		{Id: 1, OrderSide: Side_ASK, Price: 100, Quantity: 1},
+		{Id: 2, OrderSide: Side_BID, Price: 100, Quantity: 11},
+		{Id: 3, ClientId: "a", OrderType: OrderType_STOP, OrderSide: Side_BID, StopPrice: 150, Quantity: 5},
+	} {
+		if _, err := exchange.HandleOrder(ctx, order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dump, err := admin.DumpOrderBook(ctx, &SymbolRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dump.Ticker != "SPY" || !strings.Contains(dump.Book, "STOP BID ORDERS") {
+		t.Fatalf("expected the full SPY book, got %v", dump)
+	}
+
+	// The owner of the stop hears about it being cancelled
+	executionCh := NewExecutionChannel()
+	exchange.executionSessions.Store("a", executionCh)
+	response, err := admin.DeleteOrderBook(ctx, &SymbolRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Reports) != 2 || response.Reports[0].OrderId != 2 || response.Reports[1].Status != OrderStatus_CANCELLED {
+		t.Fatalf("expected orders 2 and 3 cancelled, got %v", response.Reports)
+	}
+	select {
+	case report := <-executionCh.ch:
+		if report.OrderId != 3 || report.Status != OrderStatus_CANCELLED {
+			t.Errorf("expected order 3 cancelled, got %v", report)
+		}
+	default:
+		t.Error("the owner was not sent a cancellation report")
+	}
+	if _, err := admin.DumpOrderBook(ctx, &SymbolRequest{SymbolId: 0}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected the deleted book to be gone, got %v", err)
+	}
+	for _, symbolId := range []uint64{0, 7} {
+		if state := exchange.GetOrderBookState(symbolId); state != nil {
+			t.Errorf("expected no state for symbol %d, got %v", symbolId, state)
+		}
+	}
+}
+
+func TestAdminMassCancel(t *testing.T) {
+	exchange := NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	for _, order := range []*OrderMessage{
+		{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{Id: 2, OrderSide: Side_ASK, Price: 101, Quantity: 10},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Operators can pull orders while participants cannot
+	if _, err := exchange.SetSessionState(0, ob.Closed); err != nil {
+		t.Fatal(err)
+	}
+	response, err := NewAdminServer(exchange).MassCancel(context.Background(), &MassCancelRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Reports) != 2 {
+		t.Fatalf("expected both orders cancelled, got %v", response.Reports)
+	}
+	if state := exchange.GetOrderBookState(0); len(state.Bids) != 0 || len(state.Asks) != 0 {
+		t.Errorf("the book still has orders, %s", state.ObsToString())
+	}
+}
@@ -0,0 +1,54 @@
+package exchange
+
+import ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+
+// StartAuction moves a book from continuous trading into a call auction.
+// Orders rest without matching and the indicative uncross is published on
+// the feed until Uncross is called. The symbol's session state is left alone,
+// SetSessionState runs the scheduled auctions.
+func (exchange *Exchange) StartAuction(symbolId uint64) error {
+	exchange.Mu.Lock()
+	err := exchange.startAuction(symbolId)
+	exchange.Mu.Unlock()
+	if err != nil {
+		return err
+	}
+	exchange.NotifyClients(symbolId)
+	return nil
+}
+
+// Uncross ends the book's auction, everything that crosses executes at the
+// indicative price and the book returns to continuous trading
+func (exchange *Exchange) Uncross(symbolId uint64) ([]ob.Trade, error) {
+	exchange.Mu.Lock()
+	trades, err := exchange.uncross(symbolId)
+	exchange.Mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	exchange.NotifyClients(symbolId)
+	return trades, nil
+}
+
+func (exchange *Exchange) startAuction(symbolId uint64) error {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
+	if err := exchange.orderBooks[symbolId].StartAuction(); err != nil {
+		return newSymbolError(symbolId, err)
+	}
+	return nil
+}
+
+func (exchange *Exchange) uncross(symbolId uint64) ([]ob.Trade, error) {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	trades, err := exchange.orderBooks[symbolId].Uncross()
+	if err != nil {
+		return nil, newSymbolError(symbolId, err)
+	}
+	// The next auction starts from a clean slate on the feed
+	exchange.publishers[symbolId].publishedAuction = nil
+	return trades, nil
+}
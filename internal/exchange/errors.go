@@ -0,0 +1,109 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUnknownSymbol              = errors.New("unknown symbol")
+	ErrDuplicateSymbol            = errors.New("symbol already exists")
+	ErrUnknownCommand             = errors.New("unknown command")
+	ErrInvalidOrderType           = errors.New("invalid order type")
+	ErrInvalidSide                = errors.New("invalid order side")
+	ErrInvalidTimeInForce         = errors.New("invalid time in force")
+	ErrInvalidPostOnly            = errors.New("invalid post only mode")
+	ErrInvalidSelfTradePrevention = errors.New("invalid self trade prevention mode")
+	ErrInvalidPeg                 = errors.New("invalid peg type")
+	ErrInvalidPrice               = errors.New("invalid price")
+	ErrMissingClientId            = errors.New("missing client id")
+	ErrMissingParticipantId       = errors.New("missing participant id")
+	ErrExpireTimeInPast           = errors.New("expire time has already passed")
+	ErrInvalidSessionState        = errors.New("invalid session state")
+	// The symbol's session state does not accept the command or transition
+	ErrCommandNotAllowed        = errors.New("command not allowed in the current session state")
+	ErrInvalidSessionTransition = errors.New("invalid session state transition")
+	// Retransmission was asked for messages no longer kept or not yet sent
+	ErrSequenceUnavailable = errors.New("sequence numbers not available for retransmission")
+)
+
+// SymbolError ties one of the sentinel errors above to the symbol that caused it
+type SymbolError struct {
+	SymbolId uint64
+	Err      error
+}
+
+func (err *SymbolError) Error() string {
+	return fmt.Sprintf("symbol %d: %v", err.SymbolId, err.Err)
+}
+
+func (err *SymbolError) Unwrap() error {
+	return err.Err
+}
+
+func newSymbolError(symbolId uint64, err error) error {
+	return &SymbolError{SymbolId: symbolId, Err: err}
+}
+
+// Converts an exchange or orderbook error into a gRPC status error so clients
+// receive a meaningful status code instead of codes.Unknown
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var code codes.Code
+	switch {
+	case errors.Is(err, ErrUnknownSymbol), errors.Is(err, ob.ErrUnknownOrder):
+		code = codes.NotFound
+	case errors.Is(err, ErrDuplicateSymbol), errors.Is(err, ob.ErrDuplicateOrderId), errors.Is(err, ob.ErrDuplicateGroupId):
+		code = codes.AlreadyExists
+	case errors.Is(err, ErrUnknownCommand),
+		errors.Is(err, ErrInvalidOrderType),
+		errors.Is(err, ErrInvalidSide),
+		errors.Is(err, ErrInvalidTimeInForce),
+		errors.Is(err, ErrInvalidPostOnly),
+		errors.Is(err, ErrInvalidSelfTradePrevention),
+		errors.Is(err, ErrInvalidPeg),
+		errors.Is(err, ErrInvalidPrice),
+		errors.Is(err, ErrMissingClientId),
+		errors.Is(err, ErrMissingParticipantId),
+		errors.Is(err, ErrExpireTimeInPast),
+		errors.Is(err, ErrInvalidSessionState),
+		errors.Is(err, ob.ErrInvalidTimeInForce),
+		errors.Is(err, ob.ErrInvalidExpireTime),
+		errors.Is(err, ob.ErrInvalidQuantity),
+		errors.Is(err, ob.ErrInvalidDisplayQuantity),
+		errors.Is(err, ob.ErrInvalidPostOnly),
+		errors.Is(err, ob.ErrInvalidSelfTradePrevention),
+		errors.Is(err, ob.ErrInvalidPeg),
+		errors.Is(err, ob.ErrInvalidOrderGroup),
+		errors.Is(err, ob.ErrInvalidReferenceData),
+		errors.Is(err, ob.ErrPriceNotOnTick),
+		errors.Is(err, ob.ErrQuantityNotInLots),
+		errors.Is(err, ob.ErrQuantityBelowMinimum),
+		errors.Is(err, ob.ErrQuantityAboveMaximum),
+		errors.Is(err, ob.ErrNotionalAboveMaximum):
+		code = codes.InvalidArgument
+	case errors.Is(err, ob.ErrOrderNotResting),
+		errors.Is(err, ob.ErrInvalidExecution),
+		errors.Is(err, ob.ErrPostOnlyWouldCross),
+		errors.Is(err, ob.ErrNoPegPrice),
+		errors.Is(err, ob.ErrNoMarketPrice),
+		errors.Is(err, ob.ErrNotAllowedInAuction),
+		errors.Is(err, ob.ErrAuctionInProgress),
+		errors.Is(err, ob.ErrNoAuction),
+		errors.Is(err, ob.ErrPriceOutsideBand),
+		errors.Is(err, ErrCommandNotAllowed),
+		errors.Is(err, ErrInvalidSessionTransition):
+		code = codes.FailedPrecondition
+	case errors.Is(err, ErrSequenceUnavailable):
+		code = codes.OutOfRange
+	default:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,127 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusCodes(t *testing.T) {
+	tests := map[codes.Code][]error{
+		codes.NotFound:      {ErrUnknownSymbol, ob.ErrUnknownOrder},
+		codes.AlreadyExists: {ErrDuplicateSymbol, ob.ErrDuplicateOrderId, ob.ErrDuplicateGroupId},
+		codes.InvalidArgument: {
+			ErrUnknownCommand, ErrInvalidOrderType, ErrInvalidSide, ErrInvalidTimeInForce, ErrInvalidPostOnly,
+			ErrInvalidSelfTradePrevention, ErrInvalidPeg, ErrInvalidPrice, ErrMissingClientId, ErrMissingParticipantId,
+			ErrExpireTimeInPast, ErrInvalidSessionState, ob.ErrInvalidTimeInForce, ob.ErrInvalidExpireTime, ob.ErrInvalidQuantity,
+			ob.ErrInvalidDisplayQuantity, ob.ErrInvalidPostOnly, ob.ErrInvalidSelfTradePrevention, ob.ErrInvalidPeg,
+			ob.ErrInvalidOrderGroup, ob.ErrInvalidReferenceData, ob.ErrPriceNotOnTick, ob.ErrQuantityNotInLots,
+			ob.ErrQuantityBelowMinimum, ob.ErrQuantityAboveMaximum, ob.ErrNotionalAboveMaximum,
+		},
+		codes.FailedPrecondition: {
+			ob.ErrOrderNotResting, ob.ErrInvalidExecution, ob.ErrPostOnlyWouldCross, ob.ErrNoPegPrice, ob.ErrNoMarketPrice,
+			ob.ErrNotAllowedInAuction, ob.ErrAuctionInProgress, ob.ErrNoAuction, ob.ErrPriceOutsideBand,
+			ErrCommandNotAllowed, ErrInvalidSessionTransition,
+		},
+		codes.OutOfRange: {ErrSequenceUnavailable},
+		// Broken book invariants are the exchange's fault, not the client's
+		codes.Internal: {ob.ErrOrderNotInLevel, errors.New("anything else")},
+	}
+	for code, errs := range tests {
+		for _, err := range errs {
+			if got := status.Code(toStatusError(err)); got != code {
+				t.Errorf("%v maps to %v, want %v", err, got, code)
+			}
+			// Errors reach the gRPC layer tied to their order or symbol
+			wrapped := newSymbolError(1, &ob.OrderError{OrderId: 2, Err: err})
+			if got := status.Code(toStatusError(wrapped)); got != code {
+				t.Errorf("wrapped %v maps to %v, want %v", err, got, code)
+			}
+		}
+	}
+	if toStatusError(nil) != nil {
+		t.Error("nil should stay nil")
+	}
+}
+
+// Bad requests are rejected with a status instead of taking the exchange down
+func TestHandleOrderRejects(t *testing.T) {
+	exchange := newTestExchange(t)
+	if _, err := exchange.HandleOrder(context.Background(), &OrderMessage{Id: 1, Price: 100, Quantity: 10}); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name  string
+		order *OrderMessage
+		code  codes.Code
+	}{
+		{"unknown symbol", &OrderMessage{SymbolId: 9, Id: 2, Price: 100, Quantity: 10}, codes.NotFound},
+		{"duplicate id", &OrderMessage{Id: 1, Price: 100, Quantity: 10}, codes.AlreadyExists},
+		{"zero quantity", &OrderMessage{Id: 2, Price: 100}, codes.InvalidArgument},
+		{"market good till cancel", &OrderMessage{Id: 2, OrderType: OrderType_MARKET, Quantity: 10}, codes.InvalidArgument},
+		{"unknown order type", &OrderMessage{Id: 2, OrderType: OrderType(99), Quantity: 10}, codes.InvalidArgument},
+		{"unknown command", &OrderMessage{Id: 2, Command: Command(99), Price: 100, Quantity: 10}, codes.InvalidArgument},
+		{"delete unknown order", &OrderMessage{Id: 2, Command: Command_DELETE}, codes.NotFound},
+	} {
+		_, err := exchange.HandleOrder(context.Background(), test.order)
+		if status.Code(err) != test.code {
+			t.Errorf("%s: expected %v, got %v", test.name, test.code, err)
+		}
+		var report *ExecutionReport
+		for _, detail := range status.Convert(err).Details() {
+			report, _ = detail.(*ExecutionReport)
+		}
+		if report == nil || report.Status != OrderStatus_REJECTED || report.RejectReason == "" {
+			t.Errorf("%s: expected a rejected report in the status, got %v", test.name, report)
+		}
+	}
+}
+
+// A rejected group carries the report of the order that was at fault
+func TestHandleOrderGroupRejects(t *testing.T) {
+	exchange := newTestExchange(t)
+	for _, test := range []struct {
+		name    string
+		group   *OrderGroupMessage
+		code    codes.Code
+		orderId uint64
+	}{
+		{
+			name: "bad second order",
+			group: &OrderGroupMessage{GroupId: 1, Type: OrderGroupType_OCO, Orders: []*OrderMessage{
+				{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10},
+				{Id: 2, OrderSide: Side_ASK, Price: 110},
+			}},
+			code:    codes.InvalidArgument,
+			orderId: 2,
+		},
+		{
+			name: "unknown group type",
+			group: &OrderGroupMessage{GroupId: 2, Type: OrderGroupType(99), Orders: []*OrderMessage{
+				{Id: 3, OrderSide: Side_BID, Price: 100, Quantity: 10},
+				{Id: 4, OrderSide: Side_ASK, Price: 110, Quantity: 10},
+			}},
+			code:    codes.InvalidArgument,
+			orderId: 3,
+		},
+	} {
+		_, err := exchange.HandleOrderGroup(context.Background(), test.group)
+		if status.Code(err) != test.code {
+			t.Errorf("%s: expected %v, got %v", test.name, test.code, err)
+		}
+		var report *ExecutionReport
+		for _, detail := range status.Convert(err).Details() {
+			report, _ = detail.(*ExecutionReport)
+		}
+		if report == nil || report.Status != OrderStatus_REJECTED || report.OrderId != test.orderId || report.RejectReason == "" {
+			t.Errorf("%s: expected order %d rejected in the status, got %v", test.name, test.orderId, report)
+		}
+	}
+	if _, err := exchange.HandleOrderGroup(context.Background(), &OrderGroupMessage{GroupId: 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an empty group to be rejected, got %v", err)
+	}
+}
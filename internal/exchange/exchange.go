@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,7 +13,6 @@ import (
 
 	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/proto"
 )
 
 // Strict Validation Exchange - will not accept an order for a non supported security
@@ -28,7 +28,19 @@ type Exchange struct {
 	updateCh chan struct{}
 
 	udpConn *net.UDPConn
+	channel uint64
 	clients sync.Map
+
+	executions        *executionHandler
+	executionSessions sync.Map
+	publishers        map[uint64]*marketDataPublisher
+	feedMode          FeedMode
+
+	clock        Clock
+	sessionClose time.Duration
+	// How long a volatility auction runs and when each running one ends
+	volatilityAuctionPeriod time.Duration
+	volatilityAuctionEnds   map[uint64]time.Time
 }
 
 type UpdateChannel struct {
@@ -44,8 +56,11 @@ func NewUpdateChannel() *UpdateChannel {
 	}
 }
 
-func (exchange *Exchange) SetupBroadcaster(conn *net.UDPConn) {
+// Channel is stamped on every packet so consumers listening to several
+// exchanges can tell their books apart
+func (exchange *Exchange) SetupBroadcaster(conn *net.UDPConn, channel uint64) {
 	exchange.udpConn = conn
+	exchange.channel = channel
 }
 
 type ClientMetrics struct {
@@ -111,6 +126,11 @@ func (exchange *Exchange) ProcessMetrics(metrics <-chan ClientMetrics) {
 	}
 }
 
+// FormatPrice shows one of the book's prices as a decimal at its price scale
+func (obs *OrderBookState) FormatPrice(price uint64) string {
+	return ob.FormatPrice(price, obs.PriceScale)
+}
+
 func (obs *OrderBookState) ObsToString() string {
 	var sb strings.Builder
 
@@ -120,52 +140,26 @@ func (obs *OrderBookState) ObsToString() string {
 
 	for i := len(obs.Bids) - 1; i >= 0; i-- {
 		bid := obs.Bids[i]
-		sb.WriteString(fmt.Sprintf("  Price: %d, Quantity: %d\n", bid.Price, bid.Quantity))
+		sb.WriteString(fmt.Sprintf("  Price: %s, Quantity: %d\n", obs.FormatPrice(bid.Price), bid.Quantity))
 	}
 
 	sb.WriteString("Asks:\n")
 	for _, ask := range obs.Asks {
-		sb.WriteString(fmt.Sprintf("  Price: %d, Quantity: %d\n", ask.Price, ask.Quantity))
+		sb.WriteString(fmt.Sprintf("  Price: %s, Quantity: %d\n", obs.FormatPrice(ask.Price), ask.Quantity))
 	}
 
-	sb.WriteString(fmt.Sprintf("Last Executed Price: %d\n", obs.LastExecutedPrice))
-	sb.WriteString(fmt.Sprintf("Best Bid: %d\n", obs.BestBid))
-	sb.WriteString(fmt.Sprintf("Best Ask: %d\n", obs.BestAsk))
-	sb.WriteString(fmt.Sprintf("Spread: %d\n", obs.Spread))
-
-	return sb.String()
-}
-
-func (exchange *Exchange) NotifyClients(symbolId uint64) {
-	state := exchange.GetOrderBookState(symbolId)
-	state.Timestamp = time.Now().UnixNano()
-	// Serialize the state
-	data, err := proto.Marshal(state)
-	if err != nil {
-		log.Printf("Error marshaling state: %v", err)
-		return
+	sb.WriteString(fmt.Sprintf("Last Executed Price: %s\n", obs.FormatPrice(obs.LastExecutedPrice)))
+	sb.WriteString(fmt.Sprintf("Best Bid: %s\n", obs.FormatPrice(obs.BestBid)))
+	sb.WriteString(fmt.Sprintf("Best Ask: %s\n", obs.FormatPrice(obs.BestAsk)))
+	sb.WriteString(fmt.Sprintf("Spread: %s\n", obs.FormatPrice(obs.Spread)))
+	if obs.Stale {
+		sb.WriteString("STALE - waiting for a snapshot\n")
 	}
-
-	// Broadcast via UDP
-	_, err = exchange.udpConn.Write(data)
-	if err != nil {
-		log.Printf("Error broadcasting update: %v", err)
+	if obs.SessionState != SessionState_SESSION_CONTINUOUS {
+		sb.WriteString(fmt.Sprintf("Session: %v\n", obs.SessionState))
 	}
 
-	/*
-		exchange.clients.Range(func(key, value interface{}) bool {
-			updateCh := value.(*UpdateChannel)
-			updateCh.latestState.Store(state)
-			updateCh.lastUpdateTime.Store(time.Now())
-
-			select {
-			case updateCh.ch <- state:
-			default:
-				exchange.ClearAndSendLatest(updateCh)
-			}
-			return true
-		})
-	*/
+	return sb.String()
 }
 
 // SubscribeToOrderBook implements ExchangeServiceServer.
@@ -173,8 +167,7 @@ func (exchange *Exchange) SubscribeToOrderBook(req *SubscribeRequest, stream Exc
 	symbolId := req.GetSymbolId()
 
 	if !exchange.HasOrderBook(symbolId) {
-		fmt.Printf("This security is not supported by the exchange.")
-		return nil
+		return toStatusError(newSymbolError(symbolId, ErrUnknownSymbol))
 	}
 
 	updateCh := NewUpdateChannel()
@@ -191,7 +184,11 @@ func (exchange *Exchange) SubscribeToOrderBook(req *SubscribeRequest, stream Exc
 		close(metrics)
 	}()
 
+	// The book may have been deleted since it was checked
 	initialState := exchange.GetOrderBookState(symbolId)
+	if initialState == nil {
+		return toStatusError(newSymbolError(symbolId, ErrUnknownSymbol))
+	}
 	if err := stream.Send(initialState); err != nil {
 		return err
 	}
@@ -216,98 +213,185 @@ func (exchange *Exchange) mustEmbedUnimplementedExchangeServiceServer() {
 	panic("unimplemented")
 }
 
-func (exchange *Exchange) HandleOrder(ctx context.Context, orderMessage *OrderMessage) (*OrderResponseMessage, error) {
+func (exchange *Exchange) HandleOrder(ctx context.Context, orderMessage *OrderMessage) (*ExecutionReport, error) {
+	report, err := exchange.handleOrderMessage(orderMessage)
+	if err != nil {
+		return nil, toRejectStatusError(orderMessage, err)
+	}
+
+	exchange.NotifyClients(orderMessage.SymbolId)
+	return report, nil
+}
 
-	fmt.Println("Recieved order from client!")
-	order := createOrderFromMessage(orderMessage)
+// The whole command runs under one lock so the report matches the book state
+// the command left behind
+func (exchange *Exchange) handleOrderMessage(orderMessage *OrderMessage) (*ExecutionReport, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
 
 	switch orderMessage.Command {
 	case Command_ADD:
-		exchange.AddOrder(order)
+		order, err := createOrderFromMessage(orderMessage)
+		if err != nil {
+			return nil, err
+		}
+		if err := exchange.setExpireTime(order); err != nil {
+			return nil, err
+		}
+		trades, err := exchange.addOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		return exchange.executionReport(order, trades), nil
+
 	case Command_DELETE:
-		fmt.Println("Task status is PENDING")
+		order, err := exchange.getOrder(orderMessage.SymbolId, orderMessage.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := exchange.deleteOrder(orderMessage.SymbolId, orderMessage.Id); err != nil {
+			return nil, err
+		}
+		return exchange.executionReport(order, nil), nil
+
 	case Command_CANCEL:
-		fmt.Println("Task status is ACTIVE")
+		order, err := exchange.getOrder(orderMessage.SymbolId, orderMessage.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := exchange.cancelOrder(orderMessage.SymbolId, orderMessage.Id, orderMessage.Quantity); err != nil {
+			return nil, err
+		}
+		return exchange.executionReport(order, nil), nil
+
 	case Command_REPLACE:
-		fmt.Println("Task status is COMPLETED")
+		newOrderId := orderMessage.NewId
+		if newOrderId == 0 {
+			newOrderId = orderMessage.Id
+		}
+		order, trades, err := exchange.replaceOrder(orderMessage.SymbolId, orderMessage.Id, newOrderId, orderMessage.Price)
+		if err != nil {
+			return nil, err
+		}
+		report := exchange.executionReport(order, trades)
+		report.OrigOrderId = orderMessage.Id
+		return report, nil
+
+	case Command_MODIFY:
+		order, trades, err := exchange.modifyOrder(orderMessage.SymbolId, orderMessage.Id, orderMessage.Price, orderMessage.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		return exchange.executionReport(order, trades), nil
+
 	default:
-		panic("Unknown command sent")
+		return nil, ErrUnknownCommand
 	}
+}
 
-	exchange.NotifyClients(order.GetSymbolId())
-	return &OrderResponseMessage{ExchangeStatus: exchange.String()}, nil
+// Must be called with the lock held and after the order's book has been checked
+func (exchange *Exchange) executionReport(order *ob.Order, trades []ob.Trade) *ExecutionReport {
+	restingOrder, resting := exchange.orderBooks[order.GetSymbolId()].GetOrder(order.GetId())
+	return newExecutionReport(order, resting && restingOrder == order, trades)
 }
 
 func NewExchange() *Exchange {
 	exchange := Exchange{
-		orderBooks: make(map[uint64]*ob.OrderBook),
-		symbolMap:  make(map[uint64]*ob.Symbol),
-		Name:       "New Exchange",
-		updateCh:   make(chan struct{}, 1),
+		orderBooks:   make(map[uint64]*ob.OrderBook),
+		symbolMap:    make(map[uint64]*ob.Symbol),
+		publishers:   make(map[uint64]*marketDataPublisher),
+		Name:         "New Exchange",
+		updateCh:     make(chan struct{}, 1),
+		clock:        systemClock{},
+		sessionClose: defaultSessionClose,
+
+		volatilityAuctionPeriod: defaultVolatilityAuctionPeriod,
+		volatilityAuctionEnds:   make(map[uint64]time.Time),
 	}
+	exchange.executions = newExecutionHandler(&exchange)
 	return &exchange
 }
 
-func createOrderFromMessage(orderMessage *OrderMessage) *ob.Order {
+func createOrderFromMessage(orderMessage *OrderMessage) (*ob.Order, error) {
+	orderTimeInForce, err := protoToObEnumOTIF(orderMessage.OrderTimeInForce)
+	if err != nil {
+		return nil, err
+	}
+	postOnly, err := protoToObEnumPostOnly(orderMessage.PostOnly)
+	if err != nil {
+		return nil, err
+	}
+	selfTradePrevention, err := protoToObEnumSTP(orderMessage.SelfTradePrevention)
+	if err != nil {
+		return nil, err
+	}
+	pegType, err := protoToObEnumPegType(orderMessage.PegType)
+	if err != nil {
+		return nil, err
+	}
+	if orderMessage.OrderSide != Side_ASK && orderMessage.OrderSide != Side_BID {
+		return nil, ErrInvalidSide
+	}
+	isAsk := orderMessage.OrderSide == Side_ASK
+
+	var order ob.Order
 	switch orderMessage.OrderType {
 	case OrderType_LIMIT:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.LimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.LimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.LimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderTimeInForce)
+		} else {
+			order, err = ob.LimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderTimeInForce)
 		}
 
 	case OrderType_MARKET:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.MarketAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.MarketBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.MarketAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderTimeInForce)
+		} else {
+			order, err = ob.MarketBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderTimeInForce)
 		}
 
 	case OrderType_STOP:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.StopAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.StopPrice, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.StopBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.StopPrice, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.StopAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.StopPrice, orderTimeInForce)
+		} else {
+			order, err = ob.StopBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.StopPrice, orderTimeInForce)
 		}
 
 	case OrderType_STOP_LIMIT:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.StopLimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.StopPrice, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.StopLimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.StopPrice, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.StopLimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.StopPrice, orderTimeInForce)
+		} else {
+			order, err = ob.StopLimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.StopPrice, orderTimeInForce)
 		}
 
 	case OrderType_TRAILING_STOP:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.TrailingStopAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.TrailingAmount, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.TrailingStopBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.TrailingAmount, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.TrailingStopAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.TrailingAmount, orderTimeInForce)
+		} else {
+			order, err = ob.TrailingStopBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.TrailingAmount, orderTimeInForce)
 		}
 
 	case OrderType_TRAILING_STOP_LIMIT:
-		if orderMessage.OrderSide == Side_ASK {
-			order := ob.TrailingStopLimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.TrailingAmount, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
-		} else if orderMessage.OrderSide == Side_BID {
-			order := ob.TrailingStopLimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.TrailingAmount, protoToObEnumOTIF(orderMessage.OrderTimeInForce))
-			return &order
+		if isAsk {
+			order, err = ob.TrailingStopLimitAskOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.TrailingAmount, orderTimeInForce)
+		} else {
+			order, err = ob.TrailingStopLimitBidOrder(orderMessage.Id, orderMessage.SymbolId, orderMessage.Quantity, orderMessage.Price, orderMessage.TrailingAmount, orderTimeInForce)
 		}
 
 	default:
-		panic("Unknown Ordertype in add order command")
+		return nil, ErrInvalidOrderType
 	}
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	order.SetClientId(orderMessage.ClientId)
+	order.SetDisplayQuantity(orderMessage.DisplayQuantity)
+	order.SetExpireTime(orderMessage.ExpireTime)
+	order.SetPostOnly(postOnly)
+	order.SetParticipantId(orderMessage.ParticipantId)
+	order.SetSelfTradePrevention(selfTradePrevention)
+	order.SetPeg(pegType, orderMessage.PegOffset)
+	return &order, nil
 }
 
 // RLock locks the exchange for reading
@@ -321,122 +405,279 @@ func (e *Exchange) RUnlock() {
 }
 
 // Private function because this should only ever be called by AddOrderbook
-func (exchange *Exchange) addSymbol(symbolId uint64, ticker string) {
+func (exchange *Exchange) addSymbol(symbolId uint64, ticker string, referenceData ob.ReferenceData) error {
 	// Symbol should never exist as it gets caught be AddOrderbook
-	exchange.checkOrderbookDoesNotExist(symbolId)
-	newSymbol := ob.NewSymbol(symbolId, ticker)
+	if err := exchange.checkOrderbookDoesNotExist(symbolId); err != nil {
+		return err
+	}
+	newSymbol := ob.NewSymbolWithReferenceData(symbolId, ticker, referenceData)
 	exchange.symbolMap[symbolId] = &newSymbol
+	return nil
 }
 
-func (exchange *Exchange) AddOrderbook(symbolId uint64, ticker string) {
-	exchange.checkOrderbookDoesNotExist(symbolId)
-	exchange.addSymbol(symbolId, ticker)
-	exchange.orderBooks[symbolId] = ob.NewOrderbook(symbolId)
+// Adds a book that accepts any price and quantity
+func (exchange *Exchange) AddOrderbook(symbolId uint64, ticker string) error {
+	return exchange.AddOrderbookWithReferenceData(symbolId, ticker, ob.DefaultReferenceData())
+}
+
+func (exchange *Exchange) AddOrderbookWithReferenceData(symbolId uint64, ticker string, referenceData ob.ReferenceData) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.addSymbol(symbolId, ticker, referenceData); err != nil {
+		return err
+	}
+	publisher := newMarketDataPublisher(symbolId, exchange.feedMode)
+	exchange.publishers[symbolId] = publisher
+	exchange.orderBooks[symbolId] = ob.NewOrderbookWithEventHandler(symbolId, ob.EventHandlers{exchange.executions, publisher})
+	exchange.applyReferenceData(symbolId, referenceData)
 	// Handle a new orderbook/symbol added
+	return nil
 }
 
-func (exchange *Exchange) DeleteOrderbook(symbolId uint64) {
-	// Tbh only one of these checks is needed...or tbh if you try to delete a non existent
-	// book nothing should happen, just for error checking atm.
-	exchange.checkOrderbookExists(symbolId)
+// DeleteOrderbook cancels every order resting in the book, sending their
+// owners a report and the feed the empty book, before removing the symbol.
+// Returns the cancelled orders.
+func (exchange *Exchange) DeleteOrderbook(symbolId uint64) ([]*ob.Order, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	cancelled := exchange.massCancel(symbolId, ob.AllOrders())
+	exchange.publishUpdates(exchange.publishers[symbolId])
 	delete(exchange.symbolMap, symbolId)
 	delete(exchange.orderBooks, symbolId)
-	//Handle symbol deletion
+	delete(exchange.publishers, symbolId)
+	delete(exchange.volatilityAuctionEnds, symbolId)
+	return cancelled, nil
 }
 
-func (exchange *Exchange) AddOrder(order *ob.Order) {
-	symbolId := order.GetSymbolId()
-	exchange.checkOrderbookExists(symbolId)
-	exchange.orderBooks[symbolId].AddOrder(order)
-	// Handle order added
+func (exchange *Exchange) GetSymbol(symbolId uint64) (ob.Symbol, error) {
+	exchange.Mu.RLock()
+	defer exchange.Mu.RUnlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return ob.Symbol{}, err
+	}
+	return *exchange.symbolMap[symbolId], nil
 }
 
-func (exchange *Exchange) DeleteOrder(order *ob.Order) {
-	symbolId := order.GetSymbolId()
-	exchange.checkOrderbookExists(symbolId)
-	exchange.orderBooks[symbolId].DelOrder(order.GetId())
-	// Handle order deleted
+// Every symbol the exchange trades, in id order
+func (exchange *Exchange) GetSymbols() []ob.Symbol {
+	exchange.Mu.RLock()
+	defer exchange.Mu.RUnlock()
+	symbols := make([]ob.Symbol, 0, len(exchange.symbolMap))
+	for _, symbol := range exchange.symbolMap {
+		symbols = append(symbols, *symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i].GetSymbolId() < symbols[j].GetSymbolId() })
+	return symbols
+}
+
+// DumpOrderbook shows every order resting in the book, stops and trailing
+// stops included
+func (exchange *Exchange) DumpOrderbook(symbolId uint64) (string, error) {
+	// Walking the book moves its level iterators, so this is not a read
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return "", err
+	}
+	return exchange.orderBooks[symbolId].String(), nil
+}
+
+func (exchange *Exchange) AddOrder(order *ob.Order) ([]ob.Trade, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	return exchange.addOrder(order)
+}
+
+func (exchange *Exchange) DeleteOrder(symbolId uint64, orderId uint64) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	return exchange.deleteOrder(symbolId, orderId)
 }
 
-func (exchange *Exchange) CancelOrder(order *ob.Order, cancellingQuantity uint64) {
+func (exchange *Exchange) CancelOrder(symbolId uint64, orderId uint64, cancellingQuantity uint64) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	return exchange.cancelOrder(symbolId, orderId, cancellingQuantity)
+}
+
+func (exchange *Exchange) ReplaceOrder(symbolId uint64, orderId uint64, newOrderId uint64, newPrice uint64) (*ob.Order, []ob.Trade, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	return exchange.replaceOrder(symbolId, orderId, newOrderId, newPrice)
+}
+
+func (exchange *Exchange) ModifyOrder(symbolId uint64, orderId uint64, newPrice uint64, newQuantity uint64) (*ob.Order, []ob.Trade, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	return exchange.modifyOrder(symbolId, orderId, newPrice, newQuantity)
+}
+
+// Unlocked versions of the order commands, for callers already holding the
+// lock. Each checks the command is allowed in the symbol's session state, and
+// moves the symbol into a volatility auction if the command hit its collar.
+
+func (exchange *Exchange) addOrder(order *ob.Order) ([]ob.Trade, error) {
 	symbolId := order.GetSymbolId()
-	exchange.checkOrderbookExists(symbolId)
-	if cancellingQuantity <= 0 {
-		panic("Cancelling quantity must be positive")
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_ADD); err != nil {
+		return nil, err
 	}
-	exchange.orderBooks[symbolId].CancelOrder(order.GetId(), cancellingQuantity)
-	// Handle order cancelled
+	if err := exchange.checkReferenceData(order); err != nil {
+		return nil, err
+	}
+	trades, err := exchange.orderBooks[symbolId].AddOrder(order)
+	exchange.checkVolatilityAuction(symbolId)
+	return trades, err
 }
 
-func (exchange *Exchange) ReplaceOrder(order *ob.Order, newOrderId uint64, newPrice uint64) {
-	symbolId := order.GetSymbolId()
-	exchange.checkOrderbookExists(symbolId)
-	exchange.orderBooks[symbolId].ReplaceOrder(order.GetId(), newOrderId, newPrice)
-	// Handle order replaced
+func (exchange *Exchange) getOrder(symbolId uint64, orderId uint64) (*ob.Order, error) {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	order, exists := exchange.orderBooks[symbolId].GetOrder(orderId)
+	if !exists {
+		return nil, &ob.OrderError{OrderId: orderId, Err: ob.ErrUnknownOrder}
+	}
+	return order, nil
+}
+
+func (exchange *Exchange) deleteOrder(symbolId uint64, orderId uint64) error {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_DELETE); err != nil {
+		return err
+	}
+	err := exchange.orderBooks[symbolId].DelOrder(orderId)
+	exchange.checkVolatilityAuction(symbolId)
+	return err
+}
+
+func (exchange *Exchange) cancelOrder(symbolId uint64, orderId uint64, cancellingQuantity uint64) error {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_CANCEL); err != nil {
+		return err
+	}
+	err := exchange.orderBooks[symbolId].CancelOrder(orderId, cancellingQuantity)
+	exchange.checkVolatilityAuction(symbolId)
+	return err
+}
+
+func (exchange *Exchange) replaceOrder(symbolId uint64, orderId uint64, newOrderId uint64, newPrice uint64) (*ob.Order, []ob.Trade, error) {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, nil, err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_REPLACE); err != nil {
+		return nil, nil, err
+	}
+	if err := exchange.checkAmendment(symbolId, orderId, newPrice, 0); err != nil {
+		return nil, nil, err
+	}
+	order, trades, err := exchange.orderBooks[symbolId].ReplaceOrder(orderId, newOrderId, newPrice)
+	exchange.checkVolatilityAuction(symbolId)
+	return order, trades, err
+}
+
+func (exchange *Exchange) modifyOrder(symbolId uint64, orderId uint64, newPrice uint64, newQuantity uint64) (*ob.Order, []ob.Trade, error) {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, nil, err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_MODIFY); err != nil {
+		return nil, nil, err
+	}
+	if err := exchange.checkAmendment(symbolId, orderId, newPrice, newQuantity); err != nil {
+		return nil, nil, err
+	}
+	order, trades, err := exchange.orderBooks[symbolId].ModifyOrder(orderId, newPrice, newQuantity)
+	exchange.checkVolatilityAuction(symbolId)
+	return order, trades, err
 }
 
-func (exchange *Exchange) ExecuteOrderWithSpecifiedPrice(symbolId uint64, orderId uint64, quantity uint64, price uint64) {
-	exchange.checkOrderbookExists(symbolId)
+func (exchange *Exchange) ExecuteOrderWithSpecifiedPrice(symbolId uint64, orderId uint64, quantity uint64, price uint64) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
 	orderBook := exchange.orderBooks[symbolId]
 	if quantity <= 0 {
-		panic("Quantity must be positive")
+		return ob.ErrInvalidQuantity
 	}
 	if price <= 0 {
-		panic("Price must be positive")
+		return ErrInvalidPrice
 	}
-	orderBook.ExecuteOrderWithSpecifiedPrice(orderId, quantity, price)
+	defer exchange.executions.flush()
+	err := orderBook.ExecuteOrderWithSpecifiedPrice(orderId, quantity, price)
+	exchange.checkVolatilityAuction(symbolId)
+	return err
 }
 
-func (exchange *Exchange) ExecuteOrderWithoutPrice(symbolId uint64, orderId uint64, quantity uint64) {
-	exchange.checkOrderbookExists(symbolId)
+func (exchange *Exchange) ExecuteOrderWithoutPrice(symbolId uint64, orderId uint64, quantity uint64) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
 	orderBook := exchange.orderBooks[symbolId]
 	if quantity <= 0 {
-		panic("Quantity must be positive")
+		return ob.ErrInvalidQuantity
 	}
-	orderBook.ExecuteOrderWithoutSpecifiedPrice(orderId, quantity)
+	defer exchange.executions.flush()
+	err := orderBook.ExecuteOrderWithoutSpecifiedPrice(orderId, quantity)
+	exchange.checkVolatilityAuction(symbolId)
+	return err
 }
 
-func (exchange *Exchange) checkOrderbookExists(symbolId uint64) bool {
+func (exchange *Exchange) checkOrderbookExists(symbolId uint64) error {
 	_, symbolExists := exchange.symbolMap[symbolId]
-	if !symbolExists {
-		panic("Symbol doesn't exist")
-		//return false
-	}
 	_, bookExists := exchange.orderBooks[symbolId]
-	if !bookExists {
-		panic("Orderbook doesn't exist")
-		//return false
+	if !symbolExists || !bookExists {
+		return newSymbolError(symbolId, ErrUnknownSymbol)
 	}
-	return true
+	return nil
 }
 
-func (exchange *Exchange) checkOrderbookDoesNotExist(symbolId uint64) bool {
+func (exchange *Exchange) checkOrderbookDoesNotExist(symbolId uint64) error {
 	_, symbolExists := exchange.symbolMap[symbolId]
-	if symbolExists {
-		panic("Symbol already exists")
-		//return false
-	}
 	_, bookExists := exchange.orderBooks[symbolId]
-	if bookExists {
-		panic("Orderbook already exists")
-		//return false
+	if symbolExists || bookExists {
+		return newSymbolError(symbolId, ErrDuplicateSymbol)
 	}
-	return true
+	return nil
 }
 
+// GetOrderBookState returns nil if the symbol has no book
 func (exchange *Exchange) GetOrderBookState(symbolId uint64) *OrderBookState {
-	// Should be locking the book and not the exchange - change this later
-	exchange.RLock()
-	defer exchange.RUnlock()
+	// Should be locking the book and not the exchange - change this later. Walking
+	// the book moves its level iterators, so this is not a read.
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil
+	}
 
-	orderBook := exchange.orderBooks[symbolId]
+	state := orderBookState(exchange.orderBooks[symbolId], 10)
+	state.SessionState = obToProtoSessionState(exchange.symbolMap[symbolId].GetSessionState())
+	state.PriceScale = exchange.symbolMap[symbolId].GetReferenceData().GetPriceScale()
+	return state
+}
+
+// Top depth levels of each side, best price first
+func orderBookState(orderBook *ob.OrderBook, depth int) *OrderBookState {
 	var obs OrderBookState
 
 	obs.Bids = []*Level{}
 	obs.Asks = []*Level{}
 
-	topBids := orderBook.GetTopNBids(10)
-	topAsks := orderBook.GetTopNAsks(10)
+	topBids := orderBook.GetTopNBids(depth)
+	topAsks := orderBook.GetTopNAsks(depth)
 
 	for _, bid := range topBids {
 		lvl := &Level{
@@ -478,15 +719,63 @@ func (exchange *Exchange) Test() {
 	//exchange.ui.Redraw()
 }
 
-func protoToObEnumOTIF(protoOTIF OrderTimeInForce) ob.OrderTimeInForce {
+func protoToObEnumOTIF(protoOTIF OrderTimeInForce) (ob.OrderTimeInForce, error) {
 	if protoOTIF == OrderTimeInForce_FOK {
-		return ob.FillOrKill
+		return ob.FillOrKill, nil
 	}
 	if protoOTIF == OrderTimeInForce_GTC {
-		return ob.GoodTillCancel
+		return ob.GoodTillCancel, nil
 	}
 	if protoOTIF == OrderTimeInForce_IOC {
-		return ob.ImmediateOrCancel
+		return ob.ImmediateOrCancel, nil
+	}
+	if protoOTIF == OrderTimeInForce_DAY {
+		return ob.Day, nil
+	}
+	if protoOTIF == OrderTimeInForce_GTD {
+		return ob.GoodTillDate, nil
+	}
+	return ob.GoodTillCancel, ErrInvalidTimeInForce
+}
+
+func protoToObEnumPostOnly(protoPostOnly PostOnly) (ob.PostOnly, error) {
+	switch protoPostOnly {
+	case PostOnly_POST_ONLY_OFF:
+		return ob.NotPostOnly, nil
+	case PostOnly_POST_ONLY_REJECT:
+		return ob.PostOnlyReject, nil
+	case PostOnly_POST_ONLY_REPRICE:
+		return ob.PostOnlyReprice, nil
+	}
+	return ob.NotPostOnly, ErrInvalidPostOnly
+}
+
+func protoToObEnumSTP(protoSTP SelfTradePrevention) (ob.SelfTradePrevention, error) {
+	switch protoSTP {
+	case SelfTradePrevention_STP_OFF:
+		return ob.NoSelfTradePrevention, nil
+	case SelfTradePrevention_STP_CANCEL_RESTING:
+		return ob.CancelResting, nil
+	case SelfTradePrevention_STP_CANCEL_AGGRESSOR:
+		return ob.CancelAggressor, nil
+	case SelfTradePrevention_STP_CANCEL_BOTH:
+		return ob.CancelBoth, nil
+	case SelfTradePrevention_STP_DECREMENT_AND_CANCEL:
+		return ob.DecrementAndCancel, nil
+	}
+	return ob.NoSelfTradePrevention, ErrInvalidSelfTradePrevention
+}
+
+func protoToObEnumPegType(protoPegType PegType) (ob.PegType, error) {
+	switch protoPegType {
+	case PegType_PEG_OFF:
+		return ob.NotPegged, nil
+	case PegType_PEG_PRIMARY:
+		return ob.PrimaryPeg, nil
+	case PegType_PEG_MARKET:
+		return ob.MarketPeg, nil
+	case PegType_PEG_MIDPOINT:
+		return ob.MidpointPeg, nil
 	}
-	panic("Invalid Proto OTIF")
+	return ob.NotPegged, ErrInvalidPeg
 }
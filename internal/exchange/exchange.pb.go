@@ -27,6 +27,8 @@ const (
 	Command_DELETE  Command = 1
 	Command_REPLACE Command = 2
 	Command_CANCEL  Command = 3
+	// Sets the price and open quantity, only a size-down at the same price keeps priority
+	Command_MODIFY Command = 4
 )
 
 // Enum value maps for Command.
@@ -36,12 +38,14 @@ var (
 		1: "DELETE",
 		2: "REPLACE",
 		3: "CANCEL",
+		4: "MODIFY",
 	}
 	Command_value = map[string]int32{
 		"ADD":     0,
 		"DELETE":  1,
 		"REPLACE": 2,
 		"CANCEL":  3,
+		"MODIFY":  4,
 	}
 )
 
@@ -136,6 +140,10 @@ const (
 	OrderTimeInForce_GTC OrderTimeInForce = 0
 	OrderTimeInForce_IOC OrderTimeInForce = 1
 	OrderTimeInForce_FOK OrderTimeInForce = 2
+	// Expires at the exchange's session close
+	OrderTimeInForce_DAY OrderTimeInForce = 3
+	// Expires at expireTime
+	OrderTimeInForce_GTD OrderTimeInForce = 4
 )
 
 // Enum value maps for OrderTimeInForce.
@@ -144,11 +152,15 @@ var (
 		0: "GTC",
 		1: "IOC",
 		2: "FOK",
+		3: "DAY",
+		4: "GTD",
 	}
 	OrderTimeInForce_value = map[string]int32{
 		"GTC": 0,
 		"IOC": 1,
 		"FOK": 2,
+		"DAY": 3,
+		"GTD": 4,
 	}
 )
 
@@ -179,6 +191,238 @@ func (OrderTimeInForce) EnumDescriptor() ([]byte, []int) {
 	return file_proto_exchange_proto_rawDescGZIP(), []int{2}
 }
 
+type PostOnly int32
+
+const (
+	PostOnly_POST_ONLY_OFF PostOnly = 0
+	// Rejected if the order would cross the spread
+	PostOnly_POST_ONLY_REJECT PostOnly = 1
+	// Repriced one tick behind the best opposite price instead
+	PostOnly_POST_ONLY_REPRICE PostOnly = 2
+)
+
+// Enum value maps for PostOnly.
+var (
+	PostOnly_name = map[int32]string{
+		0: "POST_ONLY_OFF",
+		1: "POST_ONLY_REJECT",
+		2: "POST_ONLY_REPRICE",
+	}
+	PostOnly_value = map[string]int32{
+		"POST_ONLY_OFF":     0,
+		"POST_ONLY_REJECT":  1,
+		"POST_ONLY_REPRICE": 2,
+	}
+)
+
+func (x PostOnly) Enum() *PostOnly {
+	p := new(PostOnly)
+	*p = x
+	return p
+}
+
+func (x PostOnly) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostOnly) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[3].Descriptor()
+}
+
+func (PostOnly) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[3]
+}
+
+func (x PostOnly) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostOnly.Descriptor instead.
+func (PostOnly) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{3}
+}
+
+// Limit orders can follow the book instead of using their price
+type PegType int32
+
+const (
+	PegType_PEG_OFF PegType = 0
+	// Best price on the order's own side
+	PegType_PEG_PRIMARY PegType = 1
+	// Best price on the opposite side
+	PegType_PEG_MARKET PegType = 2
+	// Midpoint of the best bid and ask
+	PegType_PEG_MIDPOINT PegType = 3
+)
+
+// Enum value maps for PegType.
+var (
+	PegType_name = map[int32]string{
+		0: "PEG_OFF",
+		1: "PEG_PRIMARY",
+		2: "PEG_MARKET",
+		3: "PEG_MIDPOINT",
+	}
+	PegType_value = map[string]int32{
+		"PEG_OFF":      0,
+		"PEG_PRIMARY":  1,
+		"PEG_MARKET":   2,
+		"PEG_MIDPOINT": 3,
+	}
+)
+
+func (x PegType) Enum() *PegType {
+	p := new(PegType)
+	*p = x
+	return p
+}
+
+func (x PegType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PegType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[4].Descriptor()
+}
+
+func (PegType) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[4]
+}
+
+func (x PegType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PegType.Descriptor instead.
+func (PegType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{4}
+}
+
+// Applied when an incoming order would trade with a resting order of the same participant
+type SelfTradePrevention int32
+
+const (
+	SelfTradePrevention_STP_OFF                  SelfTradePrevention = 0
+	SelfTradePrevention_STP_CANCEL_RESTING       SelfTradePrevention = 1
+	SelfTradePrevention_STP_CANCEL_AGGRESSOR     SelfTradePrevention = 2
+	SelfTradePrevention_STP_CANCEL_BOTH          SelfTradePrevention = 3
+	SelfTradePrevention_STP_DECREMENT_AND_CANCEL SelfTradePrevention = 4
+)
+
+// Enum value maps for SelfTradePrevention.
+var (
+	SelfTradePrevention_name = map[int32]string{
+		0: "STP_OFF",
+		1: "STP_CANCEL_RESTING",
+		2: "STP_CANCEL_AGGRESSOR",
+		3: "STP_CANCEL_BOTH",
+		4: "STP_DECREMENT_AND_CANCEL",
+	}
+	SelfTradePrevention_value = map[string]int32{
+		"STP_OFF":                  0,
+		"STP_CANCEL_RESTING":       1,
+		"STP_CANCEL_AGGRESSOR":     2,
+		"STP_CANCEL_BOTH":          3,
+		"STP_DECREMENT_AND_CANCEL": 4,
+	}
+)
+
+func (x SelfTradePrevention) Enum() *SelfTradePrevention {
+	p := new(SelfTradePrevention)
+	*p = x
+	return p
+}
+
+func (x SelfTradePrevention) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelfTradePrevention) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[5].Descriptor()
+}
+
+func (SelfTradePrevention) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[5]
+}
+
+func (x SelfTradePrevention) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelfTradePrevention.Descriptor instead.
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{5}
+}
+
+// Trading phase of a symbol, which decides the commands its book accepts
+type SessionState int32
+
+const (
+	// Everything is accepted, the default for new books
+	SessionState_SESSION_CONTINUOUS SessionState = 0
+	// Only cancels, order entry starts with the opening auction
+	SessionState_SESSION_PRE_OPEN SessionState = 1
+	// Orders rest without matching until the book uncrosses
+	SessionState_SESSION_OPENING_AUCTION SessionState = 2
+	// Only cancels
+	SessionState_SESSION_HALTED          SessionState = 3
+	SessionState_SESSION_CLOSING_AUCTION SessionState = 4
+	// Nothing is accepted
+	SessionState_SESSION_CLOSED SessionState = 5
+	// Started by a trade outside the price collar, continuous trading resumes
+	// once it has run for the exchange's volatility auction period
+	SessionState_SESSION_VOLATILITY_AUCTION SessionState = 6
+)
+
+// Enum value maps for SessionState.
+var (
+	SessionState_name = map[int32]string{
+		0: "SESSION_CONTINUOUS",
+		1: "SESSION_PRE_OPEN",
+		2: "SESSION_OPENING_AUCTION",
+		3: "SESSION_HALTED",
+		4: "SESSION_CLOSING_AUCTION",
+		5: "SESSION_CLOSED",
+		6: "SESSION_VOLATILITY_AUCTION",
+	}
+	SessionState_value = map[string]int32{
+		"SESSION_CONTINUOUS":         0,
+		"SESSION_PRE_OPEN":           1,
+		"SESSION_OPENING_AUCTION":    2,
+		"SESSION_HALTED":             3,
+		"SESSION_CLOSING_AUCTION":    4,
+		"SESSION_CLOSED":             5,
+		"SESSION_VOLATILITY_AUCTION": 6,
+	}
+)
+
+func (x SessionState) Enum() *SessionState {
+	p := new(SessionState)
+	*p = x
+	return p
+}
+
+func (x SessionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[6].Descriptor()
+}
+
+func (SessionState) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[6]
+}
+
+func (x SessionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionState.Descriptor instead.
+func (SessionState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{6}
+}
+
 type Side int32
 
 const (
@@ -196,70 +440,2272 @@ var (
 		"BID": 0,
 		"ASK": 1,
 	}
-)
+)
+
+func (x Side) Enum() *Side {
+	p := new(Side)
+	*p = x
+	return p
+}
+
+func (x Side) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Side) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[7].Descriptor()
+}
+
+func (Side) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[7]
+}
+
+func (x Side) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Side.Descriptor instead.
+func (Side) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{7}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_NEW              OrderStatus = 0
+	OrderStatus_PARTIALLY_FILLED OrderStatus = 1
+	OrderStatus_FILLED           OrderStatus = 2
+	OrderStatus_CANCELLED        OrderStatus = 3
+	OrderStatus_REJECTED         OrderStatus = 4
+	// Held back until its parent order fills
+	OrderStatus_PENDING_NEW OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "NEW",
+		1: "PARTIALLY_FILLED",
+		2: "FILLED",
+		3: "CANCELLED",
+		4: "REJECTED",
+		5: "PENDING_NEW",
+	}
+	OrderStatus_value = map[string]int32{
+		"NEW":              0,
+		"PARTIALLY_FILLED": 1,
+		"FILLED":           2,
+		"CANCELLED":        3,
+		"REJECTED":         4,
+		"PENDING_NEW":      5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[8].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[8]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{8}
+}
+
+type ExecutionType int32
+
+const (
+	ExecutionType_ACKNOWLEDGED ExecutionType = 0
+	ExecutionType_TRADE        ExecutionType = 1
+	ExecutionType_CANCELLATION ExecutionType = 2
+	// A stop order was activated
+	ExecutionType_TRIGGERED ExecutionType = 3
+	// A trailing stop moved its stop price
+	ExecutionType_RESTATED ExecutionType = 4
+	// A modify or replace moved the order to the back of its level, the
+	// report is for the order that now rests
+	ExecutionType_REPLACED ExecutionType = 5
+)
+
+// Enum value maps for ExecutionType.
+var (
+	ExecutionType_name = map[int32]string{
+		0: "ACKNOWLEDGED",
+		1: "TRADE",
+		2: "CANCELLATION",
+		3: "TRIGGERED",
+		4: "RESTATED",
+		5: "REPLACED",
+	}
+	ExecutionType_value = map[string]int32{
+		"ACKNOWLEDGED": 0,
+		"TRADE":        1,
+		"CANCELLATION": 2,
+		"TRIGGERED":    3,
+		"RESTATED":     4,
+		"REPLACED":     5,
+	}
+)
+
+func (x ExecutionType) Enum() *ExecutionType {
+	p := new(ExecutionType)
+	*p = x
+	return p
+}
+
+func (x ExecutionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[9].Descriptor()
+}
+
+func (ExecutionType) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[9]
+}
+
+func (x ExecutionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionType.Descriptor instead.
+func (ExecutionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{9}
+}
+
+type LevelUpdateAction int32
+
+const (
+	LevelUpdateAction_LEVEL_ADD    LevelUpdateAction = 0
+	LevelUpdateAction_LEVEL_MODIFY LevelUpdateAction = 1
+	LevelUpdateAction_LEVEL_DELETE LevelUpdateAction = 2
+)
+
+// Enum value maps for LevelUpdateAction.
+var (
+	LevelUpdateAction_name = map[int32]string{
+		0: "LEVEL_ADD",
+		1: "LEVEL_MODIFY",
+		2: "LEVEL_DELETE",
+	}
+	LevelUpdateAction_value = map[string]int32{
+		"LEVEL_ADD":    0,
+		"LEVEL_MODIFY": 1,
+		"LEVEL_DELETE": 2,
+	}
+)
+
+func (x LevelUpdateAction) Enum() *LevelUpdateAction {
+	p := new(LevelUpdateAction)
+	*p = x
+	return p
+}
+
+func (x LevelUpdateAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LevelUpdateAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[10].Descriptor()
+}
+
+func (LevelUpdateAction) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[10]
+}
+
+func (x LevelUpdateAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LevelUpdateAction.Descriptor instead.
+func (LevelUpdateAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{10}
+}
+
+type OrderUpdateAction int32
+
+const (
+	OrderUpdateAction_ORDER_ADD     OrderUpdateAction = 0
+	OrderUpdateAction_ORDER_EXECUTE OrderUpdateAction = 1
+	OrderUpdateAction_ORDER_CANCEL  OrderUpdateAction = 2
+)
+
+// Enum value maps for OrderUpdateAction.
+var (
+	OrderUpdateAction_name = map[int32]string{
+		0: "ORDER_ADD",
+		1: "ORDER_EXECUTE",
+		2: "ORDER_CANCEL",
+	}
+	OrderUpdateAction_value = map[string]int32{
+		"ORDER_ADD":     0,
+		"ORDER_EXECUTE": 1,
+		"ORDER_CANCEL":  2,
+	}
+)
+
+func (x OrderUpdateAction) Enum() *OrderUpdateAction {
+	p := new(OrderUpdateAction)
+	*p = x
+	return p
+}
+
+func (x OrderUpdateAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderUpdateAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[11].Descriptor()
+}
+
+func (OrderUpdateAction) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[11]
+}
+
+func (x OrderUpdateAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderUpdateAction.Descriptor instead.
+func (OrderUpdateAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{11}
+}
+
+type OrderGroupType int32
+
+const (
+	// A fill on any order cancels the others
+	OrderGroupType_OCO OrderGroupType = 0
+	// The first order is the parent, the rest enter once it fills
+	OrderGroupType_OTO OrderGroupType = 1
+	// OTO whose children then act as an OCO
+	OrderGroupType_BRACKET OrderGroupType = 2
+)
+
+// Enum value maps for OrderGroupType.
+var (
+	OrderGroupType_name = map[int32]string{
+		0: "OCO",
+		1: "OTO",
+		2: "BRACKET",
+	}
+	OrderGroupType_value = map[string]int32{
+		"OCO":     0,
+		"OTO":     1,
+		"BRACKET": 2,
+	}
+)
+
+func (x OrderGroupType) Enum() *OrderGroupType {
+	p := new(OrderGroupType)
+	*p = x
+	return p
+}
+
+func (x OrderGroupType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderGroupType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[12].Descriptor()
+}
+
+func (OrderGroupType) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[12]
+}
+
+func (x OrderGroupType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderGroupType.Descriptor instead.
+func (OrderGroupType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{12}
+}
+
+type OrderGroupState int32
+
+const (
+	OrderGroupState_GROUP_PENDING   OrderGroupState = 0
+	OrderGroupState_GROUP_ACTIVE    OrderGroupState = 1
+	OrderGroupState_GROUP_COMPLETED OrderGroupState = 2
+	OrderGroupState_GROUP_CANCELLED OrderGroupState = 3
+)
+
+// Enum value maps for OrderGroupState.
+var (
+	OrderGroupState_name = map[int32]string{
+		0: "GROUP_PENDING",
+		1: "GROUP_ACTIVE",
+		2: "GROUP_COMPLETED",
+		3: "GROUP_CANCELLED",
+	}
+	OrderGroupState_value = map[string]int32{
+		"GROUP_PENDING":   0,
+		"GROUP_ACTIVE":    1,
+		"GROUP_COMPLETED": 2,
+		"GROUP_CANCELLED": 3,
+	}
+)
+
+func (x OrderGroupState) Enum() *OrderGroupState {
+	p := new(OrderGroupState)
+	*p = x
+	return p
+}
+
+func (x OrderGroupState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderGroupState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_exchange_proto_enumTypes[13].Descriptor()
+}
+
+func (OrderGroupState) Type() protoreflect.EnumType {
+	return &file_proto_exchange_proto_enumTypes[13]
+}
+
+func (x OrderGroupState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderGroupState.Descriptor instead.
+func (OrderGroupState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{13}
+}
+
+type OrderMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Add, Delete, Cancel, Replace, etc
+	Command              Command          `protobuf:"varint,1,opt,name=command,proto3,enum=exchange.Command" json:"command,omitempty"`
+	OrderType            OrderType        `protobuf:"varint,2,opt,name=orderType,proto3,enum=exchange.OrderType" json:"orderType,omitempty"`
+	OrderSide            Side             `protobuf:"varint,3,opt,name=orderSide,proto3,enum=exchange.Side" json:"orderSide,omitempty"`
+	OrderTimeInForce     OrderTimeInForce `protobuf:"varint,4,opt,name=orderTimeInForce,proto3,enum=exchange.OrderTimeInForce" json:"orderTimeInForce,omitempty"`
+	Id                   uint64           `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	SymbolId             uint64           `protobuf:"varint,6,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	Price                uint64           `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	StopPrice            uint64           `protobuf:"varint,8,opt,name=stopPrice,proto3" json:"stopPrice,omitempty"`
+	TrailingAmount       uint64           `protobuf:"varint,9,opt,name=trailingAmount,proto3" json:"trailingAmount,omitempty"`
+	LastExecutedPrice    uint64           `protobuf:"varint,10,opt,name=lastExecutedPrice,proto3" json:"lastExecutedPrice,omitempty"`
+	Quantity             uint64           `protobuf:"varint,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OpenQuantity         uint64           `protobuf:"varint,12,opt,name=openQuantity,proto3" json:"openQuantity,omitempty"`
+	LastExecutedQuantity uint64           `protobuf:"varint,13,opt,name=lastExecutedQuantity,proto3" json:"lastExecutedQuantity,omitempty"`
+	// Id given to the order by a REPLACE, the original id is kept when unset
+	NewId uint64 `protobuf:"varint,14,opt,name=newId,proto3" json:"newId,omitempty"`
+	// Session that owns the order, its execution reports are streamed there
+	ClientId string `protobuf:"bytes,15,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	// Iceberg orders only show this much in the book at a time, unset shows everything
+	DisplayQuantity uint64   `protobuf:"varint,16,opt,name=displayQuantity,proto3" json:"displayQuantity,omitempty"`
+	PostOnly        PostOnly `protobuf:"varint,17,opt,name=postOnly,proto3,enum=exchange.PostOnly" json:"postOnly,omitempty"`
+	// Firm or account owning the order, self trade prevention needs it set
+	ParticipantId       uint64              `protobuf:"varint,18,opt,name=participantId,proto3" json:"participantId,omitempty"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,19,opt,name=selfTradePrevention,proto3,enum=exchange.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
+	// Unix nanoseconds, GTD orders only
+	ExpireTime int64   `protobuf:"varint,20,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	PegType    PegType `protobuf:"varint,21,opt,name=pegType,proto3,enum=exchange.PegType" json:"pegType,omitempty"`
+	// Ticks added to the pegged price, negative values lower it
+	PegOffset     int64 `protobuf:"zigzag64,22,opt,name=pegOffset,proto3" json:"pegOffset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderMessage) Reset() {
+	*x = OrderMessage{}
+	mi := &file_proto_exchange_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderMessage) ProtoMessage() {}
+
+func (x *OrderMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderMessage.ProtoReflect.Descriptor instead.
+func (*OrderMessage) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderMessage) GetCommand() Command {
+	if x != nil {
+		return x.Command
+	}
+	return Command_ADD
+}
+
+func (x *OrderMessage) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_LIMIT
+}
+
+func (x *OrderMessage) GetOrderSide() Side {
+	if x != nil {
+		return x.OrderSide
+	}
+	return Side_BID
+}
+
+func (x *OrderMessage) GetOrderTimeInForce() OrderTimeInForce {
+	if x != nil {
+		return x.OrderTimeInForce
+	}
+	return OrderTimeInForce_GTC
+}
+
+func (x *OrderMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetStopPrice() uint64 {
+	if x != nil {
+		return x.StopPrice
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetTrailingAmount() uint64 {
+	if x != nil {
+		return x.TrailingAmount
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetLastExecutedPrice() uint64 {
+	if x != nil {
+		return x.LastExecutedPrice
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetOpenQuantity() uint64 {
+	if x != nil {
+		return x.OpenQuantity
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetLastExecutedQuantity() uint64 {
+	if x != nil {
+		return x.LastExecutedQuantity
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetNewId() uint64 {
+	if x != nil {
+		return x.NewId
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OrderMessage) GetDisplayQuantity() uint64 {
+	if x != nil {
+		return x.DisplayQuantity
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetPostOnly() PostOnly {
+	if x != nil {
+		return x.PostOnly
+	}
+	return PostOnly_POST_ONLY_OFF
+}
+
+func (x *OrderMessage) GetParticipantId() uint64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetSelfTradePrevention() SelfTradePrevention {
+	if x != nil {
+		return x.SelfTradePrevention
+	}
+	return SelfTradePrevention_STP_OFF
+}
+
+func (x *OrderMessage) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *OrderMessage) GetPegType() PegType {
+	if x != nil {
+		return x.PegType
+	}
+	return PegType_PEG_OFF
+}
+
+func (x *OrderMessage) GetPegOffset() int64 {
+	if x != nil {
+		return x.PegOffset
+	}
+	return 0
+}
+
+type Fill struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TradeId  uint64                 `protobuf:"varint,1,opt,name=tradeId,proto3" json:"tradeId,omitempty"`
+	Price    uint64                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity uint64                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// True when this order took liquidity
+	Aggressor     bool  `protobuf:"varint,4,opt,name=aggressor,proto3" json:"aggressor,omitempty"`
+	Timestamp     int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fill) Reset() {
+	*x = Fill{}
+	mi := &file_proto_exchange_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fill) ProtoMessage() {}
+
+func (x *Fill) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fill.ProtoReflect.Descriptor instead.
+func (*Fill) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Fill) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *Fill) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Fill) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Fill) GetAggressor() bool {
+	if x != nil {
+		return x.Aggressor
+	}
+	return false
+}
+
+func (x *Fill) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ExecutionReport struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrderId            uint64                 `protobuf:"varint,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	SymbolId           uint64                 `protobuf:"varint,2,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	Status             OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=exchange.OrderStatus" json:"status,omitempty"`
+	CumulativeQuantity uint64                 `protobuf:"varint,4,opt,name=cumulativeQuantity,proto3" json:"cumulativeQuantity,omitempty"`
+	LeavesQuantity     uint64                 `protobuf:"varint,5,opt,name=leavesQuantity,proto3" json:"leavesQuantity,omitempty"`
+	AveragePrice       float64                `protobuf:"fixed64,6,opt,name=averagePrice,proto3" json:"averagePrice,omitempty"`
+	// Fills generated by the request, or the one fill of a streamed TRADE report
+	Fills []*Fill `protobuf:"bytes,7,rep,name=fills,proto3" json:"fills,omitempty"`
+	// Only set when status is REJECTED
+	RejectReason  string        `protobuf:"bytes,8,opt,name=rejectReason,proto3" json:"rejectReason,omitempty"`
+	ExecutionType ExecutionType `protobuf:"varint,9,opt,name=executionType,proto3,enum=exchange.ExecutionType" json:"executionType,omitempty"`
+	ClientId      string        `protobuf:"bytes,10,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	// Id of the order a replace took over from
+	OrigOrderId   uint64 `protobuf:"varint,11,opt,name=origOrderId,proto3" json:"origOrderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionReport) Reset() {
+	*x = ExecutionReport{}
+	mi := &file_proto_exchange_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionReport) ProtoMessage() {}
+
+func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionReport.ProtoReflect.Descriptor instead.
+func (*ExecutionReport) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecutionReport) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_NEW
+}
+
+func (x *ExecutionReport) GetCumulativeQuantity() uint64 {
+	if x != nil {
+		return x.CumulativeQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetLeavesQuantity() uint64 {
+	if x != nil {
+		return x.LeavesQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *ExecutionReport) GetFills() []*Fill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *ExecutionReport) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *ExecutionReport) GetExecutionType() ExecutionType {
+	if x != nil {
+		return x.ExecutionType
+	}
+	return ExecutionType_ACKNOWLEDGED
+}
+
+func (x *ExecutionReport) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ExecutionReport) GetOrigOrderId() uint64 {
+	if x != nil {
+		return x.OrigOrderId
+	}
+	return 0
+}
+
+type ExecutionSubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionSubscribeRequest) Reset() {
+	*x = ExecutionSubscribeRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionSubscribeRequest) ProtoMessage() {}
+
+func (x *ExecutionSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*ExecutionSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecutionSubscribeRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"` // Identifier for the specific orderbook
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeRequest) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+type Level struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         uint64                 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      uint64                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Level) Reset() {
+	*x = Level{}
+	mi := &file_proto_exchange_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Level) ProtoMessage() {}
+
+func (x *Level) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Level.ProtoReflect.Descriptor instead.
+func (*Level) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Level) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Level) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type OrderBookState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Bids              []*Level               `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks              []*Level               `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastExecutedPrice uint64                 `protobuf:"varint,3,opt,name=lastExecutedPrice,proto3" json:"lastExecutedPrice,omitempty"`
+	BestBid           uint64                 `protobuf:"varint,4,opt,name=bestBid,proto3" json:"bestBid,omitempty"`
+	BestAsk           uint64                 `protobuf:"varint,5,opt,name=bestAsk,proto3" json:"bestAsk,omitempty"`
+	Spread            uint64                 `protobuf:"varint,6,opt,name=spread,proto3" json:"spread,omitempty"`
+	Timestamp         int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Set by consumers whose copy of the book may be missing updates
+	Stale        bool         `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`
+	SessionState SessionState `protobuf:"varint,9,opt,name=sessionState,proto3,enum=exchange.SessionState" json:"sessionState,omitempty"`
+	// Decimal places prices are shown with, 12345 at scale 2 is 123.45
+	PriceScale    uint32 `protobuf:"varint,10,opt,name=priceScale,proto3" json:"priceScale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookState) Reset() {
+	*x = OrderBookState{}
+	mi := &file_proto_exchange_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookState) ProtoMessage() {}
+
+func (x *OrderBookState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookState.ProtoReflect.Descriptor instead.
+func (*OrderBookState) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderBookState) GetBids() []*Level {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBookState) GetAsks() []*Level {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBookState) GetLastExecutedPrice() uint64 {
+	if x != nil {
+		return x.LastExecutedPrice
+	}
+	return 0
+}
+
+func (x *OrderBookState) GetBestBid() uint64 {
+	if x != nil {
+		return x.BestBid
+	}
+	return 0
+}
+
+func (x *OrderBookState) GetBestAsk() uint64 {
+	if x != nil {
+		return x.BestAsk
+	}
+	return 0
+}
+
+func (x *OrderBookState) GetSpread() uint64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *OrderBookState) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OrderBookState) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *OrderBookState) GetSessionState() SessionState {
+	if x != nil {
+		return x.SessionState
+	}
+	return SessionState_SESSION_CONTINUOUS
+}
+
+func (x *OrderBookState) GetPriceScale() uint32 {
+	if x != nil {
+		return x.PriceScale
+	}
+	return 0
+}
+
+type LevelUpdate struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action LevelUpdateAction      `protobuf:"varint,1,opt,name=action,proto3,enum=exchange.LevelUpdateAction" json:"action,omitempty"`
+	Side   Side                   `protobuf:"varint,2,opt,name=side,proto3,enum=exchange.Side" json:"side,omitempty"`
+	Price  uint64                 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Total quantity now resting at the price, zero when the level is deleted
+	Quantity      uint64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelUpdate) Reset() {
+	*x = LevelUpdate{}
+	mi := &file_proto_exchange_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelUpdate) ProtoMessage() {}
+
+func (x *LevelUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelUpdate.ProtoReflect.Descriptor instead.
+func (*LevelUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LevelUpdate) GetAction() LevelUpdateAction {
+	if x != nil {
+		return x.Action
+	}
+	return LevelUpdateAction_LEVEL_ADD
+}
+
+func (x *LevelUpdate) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_BID
+}
+
+func (x *LevelUpdate) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *LevelUpdate) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type TradeUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=tradeId,proto3" json:"tradeId,omitempty"`
+	Price         uint64                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      uint64                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AggressorSide Side                   `protobuf:"varint,4,opt,name=aggressorSide,proto3,enum=exchange.Side" json:"aggressorSide,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeUpdate) Reset() {
+	*x = TradeUpdate{}
+	mi := &file_proto_exchange_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeUpdate) ProtoMessage() {}
+
+func (x *TradeUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeUpdate.ProtoReflect.Descriptor instead.
+func (*TradeUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TradeUpdate) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *TradeUpdate) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TradeUpdate) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TradeUpdate) GetAggressorSide() Side {
+	if x != nil {
+		return x.AggressorSide
+	}
+	return Side_BID
+}
+
+// Market by order feed message for a single resting order. Added orders join
+// the back of their level, an order leaves the book once remainingQuantity is zero.
+type OrderUpdate struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Action  OrderUpdateAction      `protobuf:"varint,1,opt,name=action,proto3,enum=exchange.OrderUpdateAction" json:"action,omitempty"`
+	OrderId uint64                 `protobuf:"varint,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Side    Side                   `protobuf:"varint,3,opt,name=side,proto3,enum=exchange.Side" json:"side,omitempty"`
+	Price   uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Quantity added, executed or cancelled by this update
+	Quantity          uint64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RemainingQuantity uint64 `protobuf:"varint,6,opt,name=remainingQuantity,proto3" json:"remainingQuantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderUpdate) Reset() {
+	*x = OrderUpdate{}
+	mi := &file_proto_exchange_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderUpdate) ProtoMessage() {}
+
+func (x *OrderUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderUpdate.ProtoReflect.Descriptor instead.
+func (*OrderUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderUpdate) GetAction() OrderUpdateAction {
+	if x != nil {
+		return x.Action
+	}
+	return OrderUpdateAction_ORDER_ADD
+}
+
+func (x *OrderUpdate) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderUpdate) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_BID
+}
+
+func (x *OrderUpdate) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderUpdate) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderUpdate) GetRemainingQuantity() uint64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+type RestingOrder struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrderId  uint64                 `protobuf:"varint,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Price    uint64                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity uint64                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Zero is the front of the level
+	QueuePosition uint32 `protobuf:"varint,4,opt,name=queuePosition,proto3" json:"queuePosition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestingOrder) Reset() {
+	*x = RestingOrder{}
+	mi := &file_proto_exchange_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestingOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestingOrder) ProtoMessage() {}
+
+func (x *RestingOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestingOrder.ProtoReflect.Descriptor instead.
+func (*RestingOrder) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestingOrder) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RestingOrder) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RestingOrder) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RestingOrder) GetQueuePosition() uint32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+// Every resting order, best price first and in time priority within a level
+type MarketByOrderSnapshot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Bids              []*RestingOrder        `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks              []*RestingOrder        `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastExecutedPrice uint64                 `protobuf:"varint,3,opt,name=lastExecutedPrice,proto3" json:"lastExecutedPrice,omitempty"`
+	SessionState      SessionState           `protobuf:"varint,4,opt,name=sessionState,proto3,enum=exchange.SessionState" json:"sessionState,omitempty"`
+	PriceScale        uint32                 `protobuf:"varint,5,opt,name=priceScale,proto3" json:"priceScale,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarketByOrderSnapshot) Reset() {
+	*x = MarketByOrderSnapshot{}
+	mi := &file_proto_exchange_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketByOrderSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketByOrderSnapshot) ProtoMessage() {}
+
+func (x *MarketByOrderSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketByOrderSnapshot.ProtoReflect.Descriptor instead.
+func (*MarketByOrderSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarketByOrderSnapshot) GetBids() []*RestingOrder {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *MarketByOrderSnapshot) GetAsks() []*RestingOrder {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *MarketByOrderSnapshot) GetLastExecutedPrice() uint64 {
+	if x != nil {
+		return x.LastExecutedPrice
+	}
+	return 0
+}
+
+func (x *MarketByOrderSnapshot) GetSessionState() SessionState {
+	if x != nil {
+		return x.SessionState
+	}
+	return SessionState_SESSION_CONTINUOUS
+}
+
+func (x *MarketByOrderSnapshot) GetPriceScale() uint32 {
+	if x != nil {
+		return x.PriceScale
+	}
+	return 0
+}
+
+// Starts every multicast packet. Sequence numbers are per book and count
+// updates and trades, a snapshot repeats the sequence number of the last
+// update it includes.
+type PacketHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the exchange feed the packet was published on
+	Channel        uint64 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	SymbolId       uint64 `protobuf:"varint,2,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	SequenceNumber uint64 `protobuf:"varint,3,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PacketHeader) Reset() {
+	*x = PacketHeader{}
+	mi := &file_proto_exchange_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacketHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketHeader) ProtoMessage() {}
+
+func (x *PacketHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketHeader.ProtoReflect.Descriptor instead.
+func (*PacketHeader) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PacketHeader) GetChannel() uint64 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+// Where a book in a call auction would uncross if the auction ended now, sent
+// whenever it changes. A zero volume means nothing crosses yet.
+type AuctionUpdate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IndicativePrice  uint64                 `protobuf:"varint,1,opt,name=indicativePrice,proto3" json:"indicativePrice,omitempty"`
+	IndicativeVolume uint64                 `protobuf:"varint,2,opt,name=indicativeVolume,proto3" json:"indicativeVolume,omitempty"`
+	// Quantity left unmatched at the indicative price
+	ImbalanceQuantity uint64 `protobuf:"varint,3,opt,name=imbalanceQuantity,proto3" json:"imbalanceQuantity,omitempty"`
+	ImbalanceSide     Side   `protobuf:"varint,4,opt,name=imbalanceSide,proto3,enum=exchange.Side" json:"imbalanceSide,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AuctionUpdate) Reset() {
+	*x = AuctionUpdate{}
+	mi := &file_proto_exchange_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionUpdate) ProtoMessage() {}
+
+func (x *AuctionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionUpdate.ProtoReflect.Descriptor instead.
+func (*AuctionUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuctionUpdate) GetIndicativePrice() uint64 {
+	if x != nil {
+		return x.IndicativePrice
+	}
+	return 0
+}
+
+func (x *AuctionUpdate) GetIndicativeVolume() uint64 {
+	if x != nil {
+		return x.IndicativeVolume
+	}
+	return 0
+}
+
+func (x *AuctionUpdate) GetImbalanceQuantity() uint64 {
+	if x != nil {
+		return x.ImbalanceQuantity
+	}
+	return 0
+}
+
+func (x *AuctionUpdate) GetImbalanceSide() Side {
+	if x != nil {
+		return x.ImbalanceSide
+	}
+	return Side_BID
+}
+
+// Sent whenever a book changes session state
+type SessionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         SessionState           `protobuf:"varint,1,opt,name=state,proto3,enum=exchange.SessionState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionStatus) Reset() {
+	*x = SessionStatus{}
+	mi := &file_proto_exchange_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStatus) ProtoMessage() {}
+
+func (x *SessionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStatus.ProtoReflect.Descriptor instead.
+func (*SessionStatus) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SessionStatus) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_SESSION_CONTINUOUS
+}
+
+// One packet of the multicast market data feed
+type MarketDataMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Header    *PacketHeader          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Timestamp int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*MarketDataMessage_Snapshot
+	//	*MarketDataMessage_LevelUpdate
+	//	*MarketDataMessage_Trade
+	//	*MarketDataMessage_OrderUpdate
+	//	*MarketDataMessage_MarketByOrderSnapshot
+	//	*MarketDataMessage_AuctionUpdate
+	//	*MarketDataMessage_SessionStatus
+	Body          isMarketDataMessage_Body `protobuf_oneof:"body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketDataMessage) Reset() {
+	*x = MarketDataMessage{}
+	mi := &file_proto_exchange_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDataMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDataMessage) ProtoMessage() {}
+
+func (x *MarketDataMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDataMessage.ProtoReflect.Descriptor instead.
+func (*MarketDataMessage) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MarketDataMessage) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MarketDataMessage) GetBody() isMarketDataMessage_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetSnapshot() *OrderBookState {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetLevelUpdate() *LevelUpdate {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_LevelUpdate); ok {
+			return x.LevelUpdate
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetTrade() *TradeUpdate {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_Trade); ok {
+			return x.Trade
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetOrderUpdate() *OrderUpdate {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_OrderUpdate); ok {
+			return x.OrderUpdate
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetMarketByOrderSnapshot() *MarketByOrderSnapshot {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_MarketByOrderSnapshot); ok {
+			return x.MarketByOrderSnapshot
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetAuctionUpdate() *AuctionUpdate {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_AuctionUpdate); ok {
+			return x.AuctionUpdate
+		}
+	}
+	return nil
+}
+
+func (x *MarketDataMessage) GetSessionStatus() *SessionStatus {
+	if x != nil {
+		if x, ok := x.Body.(*MarketDataMessage_SessionStatus); ok {
+			return x.SessionStatus
+		}
+	}
+	return nil
+}
+
+type isMarketDataMessage_Body interface {
+	isMarketDataMessage_Body()
+}
+
+type MarketDataMessage_Snapshot struct {
+	Snapshot *OrderBookState `protobuf:"bytes,3,opt,name=snapshot,proto3,oneof"`
+}
+
+type MarketDataMessage_LevelUpdate struct {
+	LevelUpdate *LevelUpdate `protobuf:"bytes,4,opt,name=levelUpdate,proto3,oneof"`
+}
+
+type MarketDataMessage_Trade struct {
+	Trade *TradeUpdate `protobuf:"bytes,5,opt,name=trade,proto3,oneof"`
+}
+
+type MarketDataMessage_OrderUpdate struct {
+	// Only sent by exchanges publishing a market by order feed
+	OrderUpdate *OrderUpdate `protobuf:"bytes,6,opt,name=orderUpdate,proto3,oneof"`
+}
+
+type MarketDataMessage_MarketByOrderSnapshot struct {
+	MarketByOrderSnapshot *MarketByOrderSnapshot `protobuf:"bytes,7,opt,name=marketByOrderSnapshot,proto3,oneof"`
+}
+
+type MarketDataMessage_AuctionUpdate struct {
+	AuctionUpdate *AuctionUpdate `protobuf:"bytes,8,opt,name=auctionUpdate,proto3,oneof"`
+}
+
+type MarketDataMessage_SessionStatus struct {
+	SessionStatus *SessionStatus `protobuf:"bytes,9,opt,name=sessionStatus,proto3,oneof"`
+}
+
+func (*MarketDataMessage_Snapshot) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_LevelUpdate) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_Trade) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_OrderUpdate) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_MarketByOrderSnapshot) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_AuctionUpdate) isMarketDataMessage_Body() {}
+
+func (*MarketDataMessage_SessionStatus) isMarketDataMessage_Body() {}
+
+type RetransmitRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	// Inclusive range of sequence numbers to send again
+	FromSequenceNumber uint64 `protobuf:"varint,2,opt,name=fromSequenceNumber,proto3" json:"fromSequenceNumber,omitempty"`
+	ToSequenceNumber   uint64 `protobuf:"varint,3,opt,name=toSequenceNumber,proto3" json:"toSequenceNumber,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RetransmitRequest) Reset() {
+	*x = RetransmitRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetransmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetransmitRequest) ProtoMessage() {}
+
+func (x *RetransmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetransmitRequest.ProtoReflect.Descriptor instead.
+func (*RetransmitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RetransmitRequest) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *RetransmitRequest) GetFromSequenceNumber() uint64 {
+	if x != nil {
+		return x.FromSequenceNumber
+	}
+	return 0
+}
+
+func (x *RetransmitRequest) GetToSequenceNumber() uint64 {
+	if x != nil {
+		return x.ToSequenceNumber
+	}
+	return 0
+}
+
+type RetransmitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*MarketDataMessage   `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetransmitResponse) Reset() {
+	*x = RetransmitResponse{}
+	mi := &file_proto_exchange_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetransmitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetransmitResponse) ProtoMessage() {}
+
+func (x *RetransmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetransmitResponse.ProtoReflect.Descriptor instead.
+func (*RetransmitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RetransmitResponse) GetMessages() []*MarketDataMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SnapshotRequest) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+type OrderGroupMessage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Type    OrderGroupType         `protobuf:"varint,2,opt,name=type,proto3,enum=exchange.OrderGroupType" json:"type,omitempty"`
+	// Every order must be an ADD for the same symbol
+	Orders        []*OrderMessage `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderGroupMessage) Reset() {
+	*x = OrderGroupMessage{}
+	mi := &file_proto_exchange_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderGroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderGroupMessage) ProtoMessage() {}
+
+func (x *OrderGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderGroupMessage.ProtoReflect.Descriptor instead.
+func (*OrderGroupMessage) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrderGroupMessage) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *OrderGroupMessage) GetType() OrderGroupType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderGroupType_OCO
+}
+
+func (x *OrderGroupMessage) GetOrders() []*OrderMessage {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type OrderGroupStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Type    OrderGroupType         `protobuf:"varint,2,opt,name=type,proto3,enum=exchange.OrderGroupType" json:"type,omitempty"`
+	State   OrderGroupState        `protobuf:"varint,3,opt,name=state,proto3,enum=exchange.OrderGroupState" json:"state,omitempty"`
+	// In submission order, the parent first for OTO and BRACKET
+	OrderIds      []uint64 `protobuf:"varint,4,rep,packed,name=orderIds,proto3" json:"orderIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderGroupStatus) Reset() {
+	*x = OrderGroupStatus{}
+	mi := &file_proto_exchange_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderGroupStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderGroupStatus) ProtoMessage() {}
+
+func (x *OrderGroupStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderGroupStatus.ProtoReflect.Descriptor instead.
+func (*OrderGroupStatus) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrderGroupStatus) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *OrderGroupStatus) GetType() OrderGroupType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderGroupType_OCO
+}
+
+func (x *OrderGroupStatus) GetState() OrderGroupState {
+	if x != nil {
+		return x.State
+	}
+	return OrderGroupState_GROUP_PENDING
+}
+
+func (x *OrderGroupStatus) GetOrderIds() []uint64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type OrderGroupReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *OrderGroupStatus      `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Reports       []*ExecutionReport     `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderGroupReport) Reset() {
+	*x = OrderGroupReport{}
+	mi := &file_proto_exchange_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderGroupReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderGroupReport) ProtoMessage() {}
+
+func (x *OrderGroupReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderGroupReport.ProtoReflect.Descriptor instead.
+func (*OrderGroupReport) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderGroupReport) GetGroup() *OrderGroupStatus {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *OrderGroupReport) GetReports() []*ExecutionReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type OrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusRequest) Reset() {
+	*x = OrderStatusRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusRequest) ProtoMessage() {}
+
+func (x *OrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*OrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OrderStatusRequest) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *OrderStatusRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type OrderStatusResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Report *ExecutionReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// Only set for orders that belong to a group
+	Group         *OrderGroupStatus `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusResponse) Reset() {
+	*x = OrderStatusResponse{}
+	mi := &file_proto_exchange_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusResponse) ProtoMessage() {}
+
+func (x *OrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*OrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OrderStatusResponse) GetReport() *ExecutionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *OrderStatusResponse) GetGroup() *OrderGroupStatus {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
 
-func (x Side) Enum() *Side {
-	p := new(Side)
-	*p = x
-	return p
+type SymbolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x Side) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *SymbolRequest) Reset() {
+	*x = SymbolRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (Side) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_exchange_proto_enumTypes[3].Descriptor()
+func (x *SymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (Side) Type() protoreflect.EnumType {
-	return &file_proto_exchange_proto_enumTypes[3]
+func (*SymbolRequest) ProtoMessage() {}
+
+func (x *SymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x Side) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use SymbolRequest.ProtoReflect.Descriptor instead.
+func (*SymbolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{24}
 }
 
-// Deprecated: Use Side.Descriptor instead.
-func (Side) EnumDescriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{3}
+func (x *SymbolRequest) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
 }
 
-type OrderMessage struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Add, Delete, Cancel, Replace, etc
-	Command              Command          `protobuf:"varint,1,opt,name=command,proto3,enum=exchange.Command" json:"command,omitempty"`
-	OrderType            OrderType        `protobuf:"varint,2,opt,name=orderType,proto3,enum=exchange.OrderType" json:"orderType,omitempty"`
-	OrderSide            Side             `protobuf:"varint,3,opt,name=orderSide,proto3,enum=exchange.Side" json:"orderSide,omitempty"`
-	OrderTimeInForce     OrderTimeInForce `protobuf:"varint,4,opt,name=orderTimeInForce,proto3,enum=exchange.OrderTimeInForce" json:"orderTimeInForce,omitempty"`
-	Id                   uint64           `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
-	SymbolId             uint64           `protobuf:"varint,6,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
-	Price                uint64           `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
-	StopPrice            uint64           `protobuf:"varint,8,opt,name=stopPrice,proto3" json:"stopPrice,omitempty"`
-	TrailingAmount       uint64           `protobuf:"varint,9,opt,name=trailingAmount,proto3" json:"trailingAmount,omitempty"`
-	LastExecutedPrice    uint64           `protobuf:"varint,10,opt,name=lastExecutedPrice,proto3" json:"lastExecutedPrice,omitempty"`
-	Quantity             uint64           `protobuf:"varint,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	OpenQuantity         uint64           `protobuf:"varint,12,opt,name=openQuantity,proto3" json:"openQuantity,omitempty"`
-	LastExecutedQuantity uint64           `protobuf:"varint,13,opt,name=lastExecutedQuantity,proto3" json:"lastExecutedQuantity,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type SessionStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	State         SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=exchange.SessionState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderMessage) Reset() {
-	*x = OrderMessage{}
-	mi := &file_proto_exchange_proto_msgTypes[0]
+func (x *SessionStateRequest) Reset() {
+	*x = SessionStateRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderMessage) String() string {
+func (x *SessionStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderMessage) ProtoMessage() {}
+func (*SessionStateRequest) ProtoMessage() {}
 
-func (x *OrderMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_exchange_proto_msgTypes[0]
+func (x *SessionStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,124 +2716,156 @@ func (x *OrderMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderMessage.ProtoReflect.Descriptor instead.
-func (*OrderMessage) Descriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SessionStateRequest.ProtoReflect.Descriptor instead.
+func (*SessionStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *OrderMessage) GetCommand() Command {
+func (x *SessionStateRequest) GetSymbolId() uint64 {
 	if x != nil {
-		return x.Command
+		return x.SymbolId
 	}
-	return Command_ADD
+	return 0
 }
 
-func (x *OrderMessage) GetOrderType() OrderType {
+func (x *SessionStateRequest) GetState() SessionState {
 	if x != nil {
-		return x.OrderType
+		return x.State
 	}
-	return OrderType_LIMIT
+	return SessionState_SESSION_CONTINUOUS
 }
 
-func (x *OrderMessage) GetOrderSide() Side {
-	if x != nil {
-		return x.OrderSide
-	}
-	return Side_BID
+// A symbol and its reference data, prices are in units of 10^-priceScale.
+// Zero tick and lot sizes are taken as 1, zero maximums as none.
+type SymbolInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	Ticker        string                 `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	SessionState  SessionState           `protobuf:"varint,3,opt,name=sessionState,proto3,enum=exchange.SessionState" json:"sessionState,omitempty"`
+	TickSize      uint64                 `protobuf:"varint,4,opt,name=tickSize,proto3" json:"tickSize,omitempty"`
+	LotSize       uint64                 `protobuf:"varint,5,opt,name=lotSize,proto3" json:"lotSize,omitempty"`
+	MinQuantity   uint64                 `protobuf:"varint,6,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	MaxQuantity   uint64                 `protobuf:"varint,7,opt,name=maxQuantity,proto3" json:"maxQuantity,omitempty"`
+	MaxNotional   uint64                 `protobuf:"varint,8,opt,name=maxNotional,proto3" json:"maxNotional,omitempty"`
+	PriceScale    uint32                 `protobuf:"varint,9,opt,name=priceScale,proto3" json:"priceScale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderMessage) GetOrderTimeInForce() OrderTimeInForce {
+func (x *SymbolInfo) Reset() {
+	*x = SymbolInfo{}
+	mi := &file_proto_exchange_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolInfo) ProtoMessage() {}
+
+func (x *SymbolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[26]
 	if x != nil {
-		return x.OrderTimeInForce
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return OrderTimeInForce_GTC
+	return mi.MessageOf(x)
 }
 
-func (x *OrderMessage) GetId() uint64 {
+// Deprecated: Use SymbolInfo.ProtoReflect.Descriptor instead.
+func (*SymbolInfo) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SymbolInfo) GetSymbolId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SymbolId
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetSymbolId() uint64 {
+func (x *SymbolInfo) GetTicker() string {
 	if x != nil {
-		return x.SymbolId
+		return x.Ticker
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderMessage) GetPrice() uint64 {
+func (x *SymbolInfo) GetSessionState() SessionState {
 	if x != nil {
-		return x.Price
+		return x.SessionState
 	}
-	return 0
+	return SessionState_SESSION_CONTINUOUS
 }
 
-func (x *OrderMessage) GetStopPrice() uint64 {
+func (x *SymbolInfo) GetTickSize() uint64 {
 	if x != nil {
-		return x.StopPrice
+		return x.TickSize
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetTrailingAmount() uint64 {
+func (x *SymbolInfo) GetLotSize() uint64 {
 	if x != nil {
-		return x.TrailingAmount
+		return x.LotSize
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetLastExecutedPrice() uint64 {
+func (x *SymbolInfo) GetMinQuantity() uint64 {
 	if x != nil {
-		return x.LastExecutedPrice
+		return x.MinQuantity
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetQuantity() uint64 {
+func (x *SymbolInfo) GetMaxQuantity() uint64 {
 	if x != nil {
-		return x.Quantity
+		return x.MaxQuantity
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetOpenQuantity() uint64 {
+func (x *SymbolInfo) GetMaxNotional() uint64 {
 	if x != nil {
-		return x.OpenQuantity
+		return x.MaxNotional
 	}
 	return 0
 }
 
-func (x *OrderMessage) GetLastExecutedQuantity() uint64 {
+func (x *SymbolInfo) GetPriceScale() uint32 {
 	if x != nil {
-		return x.LastExecutedQuantity
+		return x.PriceScale
 	}
 	return 0
 }
 
-type OrderResponseMessage struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeStatus string                 `protobuf:"bytes,1,opt,name=exchangeStatus,proto3" json:"exchangeStatus,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListSymbolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderResponseMessage) Reset() {
-	*x = OrderResponseMessage{}
-	mi := &file_proto_exchange_proto_msgTypes[1]
+func (x *ListSymbolsRequest) Reset() {
+	*x = ListSymbolsRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderResponseMessage) String() string {
+func (x *ListSymbolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderResponseMessage) ProtoMessage() {}
+func (*ListSymbolsRequest) ProtoMessage() {}
 
-func (x *OrderResponseMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_exchange_proto_msgTypes[1]
+func (x *ListSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,40 +2876,33 @@ func (x *OrderResponseMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderResponseMessage.ProtoReflect.Descriptor instead.
-func (*OrderResponseMessage) Descriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *OrderResponseMessage) GetExchangeStatus() string {
-	if x != nil {
-		return x.ExchangeStatus
-	}
-	return ""
+// Deprecated: Use ListSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*ListSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{27}
 }
 
-type SubscribeRequest struct {
+type SymbolList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"` // Identifier for the specific orderbook
+	Symbols       []*SymbolInfo          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
-	mi := &file_proto_exchange_proto_msgTypes[2]
+func (x *SymbolList) Reset() {
+	*x = SymbolList{}
+	mi := &file_proto_exchange_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *SymbolList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*SymbolList) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_exchange_proto_msgTypes[2]
+func (x *SymbolList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,41 +2913,48 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SymbolList.ProtoReflect.Descriptor instead.
+func (*SymbolList) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SubscribeRequest) GetSymbolId() uint64 {
+func (x *SymbolList) GetSymbols() []*SymbolInfo {
 	if x != nil {
-		return x.SymbolId
+		return x.Symbols
 	}
-	return 0
+	return nil
 }
 
-type Level struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         uint64                 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      uint64                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+// Orders matching every field set are cancelled
+type MassCancelRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	// Every book instead of symbolId's
+	AllSymbols bool `protobuf:"varint,2,opt,name=allSymbols,proto3" json:"allSymbols,omitempty"`
+	// Zero matches every participant
+	ParticipantId uint64 `protobuf:"varint,3,opt,name=participantId,proto3" json:"participantId,omitempty"`
+	// Only cancel orders on side
+	OneSide       bool `protobuf:"varint,4,opt,name=oneSide,proto3" json:"oneSide,omitempty"`
+	Side          Side `protobuf:"varint,5,opt,name=side,proto3,enum=exchange.Side" json:"side,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Level) Reset() {
-	*x = Level{}
-	mi := &file_proto_exchange_proto_msgTypes[3]
+func (x *MassCancelRequest) Reset() {
+	*x = MassCancelRequest{}
+	mi := &file_proto_exchange_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Level) String() string {
+func (x *MassCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Level) ProtoMessage() {}
+func (*MassCancelRequest) ProtoMessage() {}
 
-func (x *Level) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_exchange_proto_msgTypes[3]
+func (x *MassCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,53 +2965,69 @@ func (x *Level) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Level.ProtoReflect.Descriptor instead.
-func (*Level) Descriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MassCancelRequest.ProtoReflect.Descriptor instead.
+func (*MassCancelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Level) GetPrice() uint64 {
+func (x *MassCancelRequest) GetSymbolId() uint64 {
 	if x != nil {
-		return x.Price
+		return x.SymbolId
 	}
 	return 0
 }
 
-func (x *Level) GetQuantity() uint64 {
+func (x *MassCancelRequest) GetAllSymbols() bool {
 	if x != nil {
-		return x.Quantity
+		return x.AllSymbols
+	}
+	return false
+}
+
+func (x *MassCancelRequest) GetParticipantId() uint64 {
+	if x != nil {
+		return x.ParticipantId
 	}
 	return 0
 }
 
-type OrderBookState struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Bids              []*Level               `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
-	Asks              []*Level               `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
-	LastExecutedPrice uint64                 `protobuf:"varint,3,opt,name=lastExecutedPrice,proto3" json:"lastExecutedPrice,omitempty"`
-	BestBid           uint64                 `protobuf:"varint,4,opt,name=bestBid,proto3" json:"bestBid,omitempty"`
-	BestAsk           uint64                 `protobuf:"varint,5,opt,name=bestAsk,proto3" json:"bestAsk,omitempty"`
-	Spread            uint64                 `protobuf:"varint,6,opt,name=spread,proto3" json:"spread,omitempty"`
-	Timestamp         int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *MassCancelRequest) GetOneSide() bool {
+	if x != nil {
+		return x.OneSide
+	}
+	return false
 }
 
-func (x *OrderBookState) Reset() {
-	*x = OrderBookState{}
-	mi := &file_proto_exchange_proto_msgTypes[4]
+func (x *MassCancelRequest) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_BID
+}
+
+// One cancellation report per order taken out of the book
+type MassCancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*ExecutionReport     `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MassCancelResponse) Reset() {
+	*x = MassCancelResponse{}
+	mi := &file_proto_exchange_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBookState) String() string {
+func (x *MassCancelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderBookState) ProtoMessage() {}
+func (*MassCancelResponse) ProtoMessage() {}
 
-func (x *OrderBookState) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_exchange_proto_msgTypes[4]
+func (x *MassCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,58 +3038,77 @@ func (x *OrderBookState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderBookState.ProtoReflect.Descriptor instead.
-func (*OrderBookState) Descriptor() ([]byte, []int) {
-	return file_proto_exchange_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MassCancelResponse.ProtoReflect.Descriptor instead.
+func (*MassCancelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *OrderBookState) GetBids() []*Level {
+func (x *MassCancelResponse) GetReports() []*ExecutionReport {
 	if x != nil {
-		return x.Bids
+		return x.Reports
 	}
 	return nil
 }
 
-func (x *OrderBookState) GetAsks() []*Level {
-	if x != nil {
-		return x.Asks
-	}
-	return nil
+// Every resting order, stops and trailing stops included
+type OrderBookDump struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbolId,proto3" json:"symbolId,omitempty"`
+	Ticker        string                 `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Book          string                 `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderBookState) GetLastExecutedPrice() uint64 {
-	if x != nil {
-		return x.LastExecutedPrice
-	}
-	return 0
+func (x *OrderBookDump) Reset() {
+	*x = OrderBookDump{}
+	mi := &file_proto_exchange_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBookState) GetBestBid() uint64 {
+func (x *OrderBookDump) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookDump) ProtoMessage() {}
+
+func (x *OrderBookDump) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_exchange_proto_msgTypes[31]
 	if x != nil {
-		return x.BestBid
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OrderBookState) GetBestAsk() uint64 {
+// Deprecated: Use OrderBookDump.ProtoReflect.Descriptor instead.
+func (*OrderBookDump) Descriptor() ([]byte, []int) {
+	return file_proto_exchange_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrderBookDump) GetSymbolId() uint64 {
 	if x != nil {
-		return x.BestAsk
+		return x.SymbolId
 	}
 	return 0
 }
 
-func (x *OrderBookState) GetSpread() uint64 {
+func (x *OrderBookDump) GetTicker() string {
 	if x != nil {
-		return x.Spread
+		return x.Ticker
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderBookState) GetTimestamp() int64 {
+func (x *OrderBookDump) GetBook() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Book
 	}
-	return 0
+	return ""
 }
 
 var File_proto_exchange_proto protoreflect.FileDescriptor
@@ -603,7 +3116,7 @@ var File_proto_exchange_proto protoreflect.FileDescriptor
 var file_proto_exchange_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x22, 0x8e, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x22, 0xfc, 0x06, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x31,
@@ -636,60 +3149,486 @@ var file_proto_exchange_proto_rawDesc = []byte{
 	0x14, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6c, 0x61, 0x73,
 	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x22, 0x3e, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x2e, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49,
-	0x64, 0x22, 0x39, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xf2, 0x01, 0x0a,
-	0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x04,
-	0x62, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x42,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x42, 0x69,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x70, 0x72,
-	0x65, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2a, 0x37, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x07, 0x0a, 0x03,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x65, 0x77, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x65, 0x77, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4f,
+	0x6e, 0x6c, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x13, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x13, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x65, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x50, 0x65, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x70, 0x65, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x67, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x12, 0x52, 0x09, 0x70, 0x65, 0x67, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x8e, 0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0xb9, 0x03, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x6c, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xe4, 0x02, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x65,
+	0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x34,
+	0x0a, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x69, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x53, 0x69, 0x64, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x64,
+	0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x15, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73,
+	0x12, 0x2a, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0d, 0x69, 0x6d,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x64,
+	0x65, 0x52, 0x0d, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x64, 0x65,
+	0x22, 0x3d, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xa1, 0x04, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x57, 0x0a, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x48, 0x00, 0x52, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x74, 0x6f, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x2d, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x22,
+	0x8b, 0x01, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xa7, 0x01,
+	0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x79, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7a,
+	0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2b, 0x0a, 0x0d, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0a, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x73, 0x73,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
+	0x6c, 0x6c, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x69, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x73, 0x69, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x22, 0x49, 0x0a,
+	0x12, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x2a, 0x43, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x07, 0x0a, 0x03,
 	0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
 	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x03, 0x2a, 0x68, 0x0a, 0x09, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d, 0x49, 0x54,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x08,
-	0x0a, 0x04, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f, 0x50,
-	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x52, 0x41, 0x49,
-	0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x54,
-	0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4d,
-	0x49, 0x54, 0x10, 0x05, 0x2a, 0x2d, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d,
-	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x43, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f,
-	0x4b, 0x10, 0x02, 0x2a, 0x18, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x42,
-	0x49, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x32, 0xac, 0x01,
-	0x0a, 0x0f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x47, 0x0a, 0x0b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x14, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
-	0x6f, 0x6b, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f,
+	0x44, 0x49, 0x46, 0x59, 0x10, 0x04, 0x2a, 0x68, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54,
+	0x4f, 0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x52, 0x41, 0x49, 0x4c,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x05,
+	0x2a, 0x3f, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x43, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x4f, 0x43, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4b, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x44, 0x10,
+	0x04, 0x2a, 0x4a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x49, 0x0a,
+	0x07, 0x50, 0x65, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x47, 0x5f,
+	0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x47, 0x5f, 0x50, 0x52, 0x49,
+	0x4d, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x47, 0x5f, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x47, 0x5f, 0x4d, 0x49,
+	0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x87, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6c,
+	0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x50, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x54, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x50, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x42, 0x4f,
+	0x54, 0x48, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x50, 0x5f, 0x44, 0x45, 0x43, 0x52,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x10, 0x04, 0x2a, 0xbe, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f, 0x55, 0x53, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x56,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x06, 0x2a, 0x18, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x49, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x2a, 0x66, 0x0a,
+	0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03,
+	0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c,
+	0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x4e, 0x45, 0x57, 0x10, 0x05, 0x2a, 0x69, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4c, 0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x44,
+	0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x54, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x05,
+	0x2a, 0x46, 0x0a, 0x11, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x41,
+	0x44, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4d, 0x4f,
+	0x44, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x47, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a,
+	0x09, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10,
+	0x02, 0x2a, 0x2f, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x43, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x32, 0x88, 0x05, 0x0a, 0x0f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x19, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x14,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5b,
+	0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x52,
+	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x10, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x1a, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x61,
+	0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x73, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0x87, 0x05, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x41, 0x64, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x14, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4d,
+	0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x48, 0x61, 0x6c, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x4d, 0x61, 0x73,
+	0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x44, 0x75, 0x6d, 0x70, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
 	0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x0c, 0x5a, 0x0a,
-	0x2e, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x6f, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -704,35 +3643,141 @@ func file_proto_exchange_proto_rawDescGZIP() []byte {
 	return file_proto_exchange_proto_rawDescData
 }
 
-var file_proto_exchange_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_exchange_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_proto_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_proto_exchange_proto_goTypes = []any{
-	(Command)(0),                 // 0: exchange.Command
-	(OrderType)(0),               // 1: exchange.OrderType
-	(OrderTimeInForce)(0),        // 2: exchange.OrderTimeInForce
-	(Side)(0),                    // 3: exchange.Side
-	(*OrderMessage)(nil),         // 4: exchange.OrderMessage
-	(*OrderResponseMessage)(nil), // 5: exchange.OrderResponseMessage
-	(*SubscribeRequest)(nil),     // 6: exchange.SubscribeRequest
-	(*Level)(nil),                // 7: exchange.Level
-	(*OrderBookState)(nil),       // 8: exchange.OrderBookState
+	(Command)(0),                      // 0: exchange.Command
+	(OrderType)(0),                    // 1: exchange.OrderType
+	(OrderTimeInForce)(0),             // 2: exchange.OrderTimeInForce
+	(PostOnly)(0),                     // 3: exchange.PostOnly
+	(PegType)(0),                      // 4: exchange.PegType
+	(SelfTradePrevention)(0),          // 5: exchange.SelfTradePrevention
+	(SessionState)(0),                 // 6: exchange.SessionState
+	(Side)(0),                         // 7: exchange.Side
+	(OrderStatus)(0),                  // 8: exchange.OrderStatus
+	(ExecutionType)(0),                // 9: exchange.ExecutionType
+	(LevelUpdateAction)(0),            // 10: exchange.LevelUpdateAction
+	(OrderUpdateAction)(0),            // 11: exchange.OrderUpdateAction
+	(OrderGroupType)(0),               // 12: exchange.OrderGroupType
+	(OrderGroupState)(0),              // 13: exchange.OrderGroupState
+	(*OrderMessage)(nil),              // 14: exchange.OrderMessage
+	(*Fill)(nil),                      // 15: exchange.Fill
+	(*ExecutionReport)(nil),           // 16: exchange.ExecutionReport
+	(*ExecutionSubscribeRequest)(nil), // 17: exchange.ExecutionSubscribeRequest
+	(*SubscribeRequest)(nil),          // 18: exchange.SubscribeRequest
+	(*Level)(nil),                     // 19: exchange.Level
+	(*OrderBookState)(nil),            // 20: exchange.OrderBookState
+	(*LevelUpdate)(nil),               // 21: exchange.LevelUpdate
+	(*TradeUpdate)(nil),               // 22: exchange.TradeUpdate
+	(*OrderUpdate)(nil),               // 23: exchange.OrderUpdate
+	(*RestingOrder)(nil),              // 24: exchange.RestingOrder
+	(*MarketByOrderSnapshot)(nil),     // 25: exchange.MarketByOrderSnapshot
+	(*PacketHeader)(nil),              // 26: exchange.PacketHeader
+	(*AuctionUpdate)(nil),             // 27: exchange.AuctionUpdate
+	(*SessionStatus)(nil),             // 28: exchange.SessionStatus
+	(*MarketDataMessage)(nil),         // 29: exchange.MarketDataMessage
+	(*RetransmitRequest)(nil),         // 30: exchange.RetransmitRequest
+	(*RetransmitResponse)(nil),        // 31: exchange.RetransmitResponse
+	(*SnapshotRequest)(nil),           // 32: exchange.SnapshotRequest
+	(*OrderGroupMessage)(nil),         // 33: exchange.OrderGroupMessage
+	(*OrderGroupStatus)(nil),          // 34: exchange.OrderGroupStatus
+	(*OrderGroupReport)(nil),          // 35: exchange.OrderGroupReport
+	(*OrderStatusRequest)(nil),        // 36: exchange.OrderStatusRequest
+	(*OrderStatusResponse)(nil),       // 37: exchange.OrderStatusResponse
+	(*SymbolRequest)(nil),             // 38: exchange.SymbolRequest
+	(*SessionStateRequest)(nil),       // 39: exchange.SessionStateRequest
+	(*SymbolInfo)(nil),                // 40: exchange.SymbolInfo
+	(*ListSymbolsRequest)(nil),        // 41: exchange.ListSymbolsRequest
+	(*SymbolList)(nil),                // 42: exchange.SymbolList
+	(*MassCancelRequest)(nil),         // 43: exchange.MassCancelRequest
+	(*MassCancelResponse)(nil),        // 44: exchange.MassCancelResponse
+	(*OrderBookDump)(nil),             // 45: exchange.OrderBookDump
 }
 var file_proto_exchange_proto_depIdxs = []int32{
-	0, // 0: exchange.OrderMessage.command:type_name -> exchange.Command
-	1, // 1: exchange.OrderMessage.orderType:type_name -> exchange.OrderType
-	3, // 2: exchange.OrderMessage.orderSide:type_name -> exchange.Side
-	2, // 3: exchange.OrderMessage.orderTimeInForce:type_name -> exchange.OrderTimeInForce
-	7, // 4: exchange.OrderBookState.bids:type_name -> exchange.Level
-	7, // 5: exchange.OrderBookState.asks:type_name -> exchange.Level
-	4, // 6: exchange.ExchangeService.HandleOrder:input_type -> exchange.OrderMessage
-	6, // 7: exchange.ExchangeService.SubscribeToOrderBook:input_type -> exchange.SubscribeRequest
-	5, // 8: exchange.ExchangeService.HandleOrder:output_type -> exchange.OrderResponseMessage
-	8, // 9: exchange.ExchangeService.SubscribeToOrderBook:output_type -> exchange.OrderBookState
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: exchange.OrderMessage.command:type_name -> exchange.Command
+	1,  // 1: exchange.OrderMessage.orderType:type_name -> exchange.OrderType
+	7,  // 2: exchange.OrderMessage.orderSide:type_name -> exchange.Side
+	2,  // 3: exchange.OrderMessage.orderTimeInForce:type_name -> exchange.OrderTimeInForce
+	3,  // 4: exchange.OrderMessage.postOnly:type_name -> exchange.PostOnly
+	5,  // 5: exchange.OrderMessage.selfTradePrevention:type_name -> exchange.SelfTradePrevention
+	4,  // 6: exchange.OrderMessage.pegType:type_name -> exchange.PegType
+	8,  // 7: exchange.ExecutionReport.status:type_name -> exchange.OrderStatus
+	15, // 8: exchange.ExecutionReport.fills:type_name -> exchange.Fill
+	9,  // 9: exchange.ExecutionReport.executionType:type_name -> exchange.ExecutionType
+	19, // 10: exchange.OrderBookState.bids:type_name -> exchange.Level
+	19, // 11: exchange.OrderBookState.asks:type_name -> exchange.Level
+	6,  // 12: exchange.OrderBookState.sessionState:type_name -> exchange.SessionState
+	10, // 13: exchange.LevelUpdate.action:type_name -> exchange.LevelUpdateAction
+	7,  // 14: exchange.LevelUpdate.side:type_name -> exchange.Side
+	7,  // 15: exchange.TradeUpdate.aggressorSide:type_name -> exchange.Side
+	11, // 16: exchange.OrderUpdate.action:type_name -> exchange.OrderUpdateAction
+	7,  // 17: exchange.OrderUpdate.side:type_name -> exchange.Side
+	24, // 18: exchange.MarketByOrderSnapshot.bids:type_name -> exchange.RestingOrder
+	24, // 19: exchange.MarketByOrderSnapshot.asks:type_name -> exchange.RestingOrder
+	6,  // 20: exchange.MarketByOrderSnapshot.sessionState:type_name -> exchange.SessionState
+	7,  // 21: exchange.AuctionUpdate.imbalanceSide:type_name -> exchange.Side
+	6,  // 22: exchange.SessionStatus.state:type_name -> exchange.SessionState
+	26, // 23: exchange.MarketDataMessage.header:type_name -> exchange.PacketHeader
+	20, // 24: exchange.MarketDataMessage.snapshot:type_name -> exchange.OrderBookState
+	21, // 25: exchange.MarketDataMessage.levelUpdate:type_name -> exchange.LevelUpdate
+	22, // 26: exchange.MarketDataMessage.trade:type_name -> exchange.TradeUpdate
+	23, // 27: exchange.MarketDataMessage.orderUpdate:type_name -> exchange.OrderUpdate
+	25, // 28: exchange.MarketDataMessage.marketByOrderSnapshot:type_name -> exchange.MarketByOrderSnapshot
+	27, // 29: exchange.MarketDataMessage.auctionUpdate:type_name -> exchange.AuctionUpdate
+	28, // 30: exchange.MarketDataMessage.sessionStatus:type_name -> exchange.SessionStatus
+	29, // 31: exchange.RetransmitResponse.messages:type_name -> exchange.MarketDataMessage
+	12, // 32: exchange.OrderGroupMessage.type:type_name -> exchange.OrderGroupType
+	14, // 33: exchange.OrderGroupMessage.orders:type_name -> exchange.OrderMessage
+	12, // 34: exchange.OrderGroupStatus.type:type_name -> exchange.OrderGroupType
+	13, // 35: exchange.OrderGroupStatus.state:type_name -> exchange.OrderGroupState
+	34, // 36: exchange.OrderGroupReport.group:type_name -> exchange.OrderGroupStatus
+	16, // 37: exchange.OrderGroupReport.reports:type_name -> exchange.ExecutionReport
+	16, // 38: exchange.OrderStatusResponse.report:type_name -> exchange.ExecutionReport
+	34, // 39: exchange.OrderStatusResponse.group:type_name -> exchange.OrderGroupStatus
+	6,  // 40: exchange.SessionStateRequest.state:type_name -> exchange.SessionState
+	6,  // 41: exchange.SymbolInfo.sessionState:type_name -> exchange.SessionState
+	40, // 42: exchange.SymbolList.symbols:type_name -> exchange.SymbolInfo
+	7,  // 43: exchange.MassCancelRequest.side:type_name -> exchange.Side
+	16, // 44: exchange.MassCancelResponse.reports:type_name -> exchange.ExecutionReport
+	14, // 45: exchange.ExchangeService.HandleOrder:input_type -> exchange.OrderMessage
+	18, // 46: exchange.ExchangeService.SubscribeToOrderBook:input_type -> exchange.SubscribeRequest
+	17, // 47: exchange.ExchangeService.SubscribeToExecutions:input_type -> exchange.ExecutionSubscribeRequest
+	30, // 48: exchange.ExchangeService.Retransmit:input_type -> exchange.RetransmitRequest
+	32, // 49: exchange.ExchangeService.GetSnapshot:input_type -> exchange.SnapshotRequest
+	33, // 50: exchange.ExchangeService.HandleOrderGroup:input_type -> exchange.OrderGroupMessage
+	36, // 51: exchange.ExchangeService.GetOrderStatus:input_type -> exchange.OrderStatusRequest
+	43, // 52: exchange.ExchangeService.CancelOwnOrders:input_type -> exchange.MassCancelRequest
+	39, // 53: exchange.AdminService.SetSessionState:input_type -> exchange.SessionStateRequest
+	38, // 54: exchange.AdminService.GetSessionState:input_type -> exchange.SymbolRequest
+	41, // 55: exchange.AdminService.ListSymbols:input_type -> exchange.ListSymbolsRequest
+	40, // 56: exchange.AdminService.AddOrderBook:input_type -> exchange.SymbolInfo
+	38, // 57: exchange.AdminService.DeleteOrderBook:input_type -> exchange.SymbolRequest
+	38, // 58: exchange.AdminService.HaltSymbol:input_type -> exchange.SymbolRequest
+	38, // 59: exchange.AdminService.ResumeSymbol:input_type -> exchange.SymbolRequest
+	43, // 60: exchange.AdminService.MassCancel:input_type -> exchange.MassCancelRequest
+	38, // 61: exchange.AdminService.DumpOrderBook:input_type -> exchange.SymbolRequest
+	16, // 62: exchange.ExchangeService.HandleOrder:output_type -> exchange.ExecutionReport
+	20, // 63: exchange.ExchangeService.SubscribeToOrderBook:output_type -> exchange.OrderBookState
+	16, // 64: exchange.ExchangeService.SubscribeToExecutions:output_type -> exchange.ExecutionReport
+	31, // 65: exchange.ExchangeService.Retransmit:output_type -> exchange.RetransmitResponse
+	29, // 66: exchange.ExchangeService.GetSnapshot:output_type -> exchange.MarketDataMessage
+	35, // 67: exchange.ExchangeService.HandleOrderGroup:output_type -> exchange.OrderGroupReport
+	37, // 68: exchange.ExchangeService.GetOrderStatus:output_type -> exchange.OrderStatusResponse
+	44, // 69: exchange.ExchangeService.CancelOwnOrders:output_type -> exchange.MassCancelResponse
+	28, // 70: exchange.AdminService.SetSessionState:output_type -> exchange.SessionStatus
+	28, // 71: exchange.AdminService.GetSessionState:output_type -> exchange.SessionStatus
+	42, // 72: exchange.AdminService.ListSymbols:output_type -> exchange.SymbolList
+	40, // 73: exchange.AdminService.AddOrderBook:output_type -> exchange.SymbolInfo
+	44, // 74: exchange.AdminService.DeleteOrderBook:output_type -> exchange.MassCancelResponse
+	28, // 75: exchange.AdminService.HaltSymbol:output_type -> exchange.SessionStatus
+	28, // 76: exchange.AdminService.ResumeSymbol:output_type -> exchange.SessionStatus
+	44, // 77: exchange.AdminService.MassCancel:output_type -> exchange.MassCancelResponse
+	45, // 78: exchange.AdminService.DumpOrderBook:output_type -> exchange.OrderBookDump
+	62, // [62:79] is the sub-list for method output_type
+	45, // [45:62] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_proto_exchange_proto_init() }
@@ -740,15 +3785,24 @@ func file_proto_exchange_proto_init() {
 	if File_proto_exchange_proto != nil {
 		return
 	}
+	file_proto_exchange_proto_msgTypes[15].OneofWrappers = []any{
+		(*MarketDataMessage_Snapshot)(nil),
+		(*MarketDataMessage_LevelUpdate)(nil),
+		(*MarketDataMessage_Trade)(nil),
+		(*MarketDataMessage_OrderUpdate)(nil),
+		(*MarketDataMessage_MarketByOrderSnapshot)(nil),
+		(*MarketDataMessage_AuctionUpdate)(nil),
+		(*MarketDataMessage_SessionStatus)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_exchange_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   5,
+			NumEnums:      14,
+			NumMessages:   32,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_exchange_proto_goTypes,
 		DependencyIndexes: file_proto_exchange_proto_depIdxs,
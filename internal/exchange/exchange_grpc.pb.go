@@ -19,16 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExchangeService_HandleOrder_FullMethodName          = "/exchange.ExchangeService/HandleOrder"
-	ExchangeService_SubscribeToOrderBook_FullMethodName = "/exchange.ExchangeService/SubscribeToOrderBook"
+	ExchangeService_HandleOrder_FullMethodName           = "/exchange.ExchangeService/HandleOrder"
+	ExchangeService_SubscribeToOrderBook_FullMethodName  = "/exchange.ExchangeService/SubscribeToOrderBook"
+	ExchangeService_SubscribeToExecutions_FullMethodName = "/exchange.ExchangeService/SubscribeToExecutions"
+	ExchangeService_Retransmit_FullMethodName            = "/exchange.ExchangeService/Retransmit"
+	ExchangeService_GetSnapshot_FullMethodName           = "/exchange.ExchangeService/GetSnapshot"
+	ExchangeService_HandleOrderGroup_FullMethodName      = "/exchange.ExchangeService/HandleOrderGroup"
+	ExchangeService_GetOrderStatus_FullMethodName        = "/exchange.ExchangeService/GetOrderStatus"
+	ExchangeService_CancelOwnOrders_FullMethodName       = "/exchange.ExchangeService/CancelOwnOrders"
 )
 
 // ExchangeServiceClient is the client API for ExchangeService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ExchangeServiceClient interface {
-	HandleOrder(ctx context.Context, in *OrderMessage, opts ...grpc.CallOption) (*OrderResponseMessage, error)
+	HandleOrder(ctx context.Context, in *OrderMessage, opts ...grpc.CallOption) (*ExecutionReport, error)
 	SubscribeToOrderBook(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderBookState], error)
+	SubscribeToExecutions(ctx context.Context, in *ExecutionSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecutionReport], error)
+	Retransmit(ctx context.Context, in *RetransmitRequest, opts ...grpc.CallOption) (*RetransmitResponse, error)
+	GetSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*MarketDataMessage, error)
+	HandleOrderGroup(ctx context.Context, in *OrderGroupMessage, opts ...grpc.CallOption) (*OrderGroupReport, error)
+	GetOrderStatus(ctx context.Context, in *OrderStatusRequest, opts ...grpc.CallOption) (*OrderStatusResponse, error)
+	// Cancels the caller's own resting orders, participantId must be set
+	CancelOwnOrders(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelResponse, error)
 }
 
 type exchangeServiceClient struct {
@@ -39,9 +52,9 @@ func NewExchangeServiceClient(cc grpc.ClientConnInterface) ExchangeServiceClient
 	return &exchangeServiceClient{cc}
 }
 
-func (c *exchangeServiceClient) HandleOrder(ctx context.Context, in *OrderMessage, opts ...grpc.CallOption) (*OrderResponseMessage, error) {
+func (c *exchangeServiceClient) HandleOrder(ctx context.Context, in *OrderMessage, opts ...grpc.CallOption) (*ExecutionReport, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OrderResponseMessage)
+	out := new(ExecutionReport)
 	err := c.cc.Invoke(ctx, ExchangeService_HandleOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -68,12 +81,88 @@ func (c *exchangeServiceClient) SubscribeToOrderBook(ctx context.Context, in *Su
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExchangeService_SubscribeToOrderBookClient = grpc.ServerStreamingClient[OrderBookState]
 
+func (c *exchangeServiceClient) SubscribeToExecutions(ctx context.Context, in *ExecutionSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecutionReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExchangeService_ServiceDesc.Streams[1], ExchangeService_SubscribeToExecutions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecutionSubscribeRequest, ExecutionReport]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExchangeService_SubscribeToExecutionsClient = grpc.ServerStreamingClient[ExecutionReport]
+
+func (c *exchangeServiceClient) Retransmit(ctx context.Context, in *RetransmitRequest, opts ...grpc.CallOption) (*RetransmitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetransmitResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_Retransmit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) GetSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*MarketDataMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketDataMessage)
+	err := c.cc.Invoke(ctx, ExchangeService_GetSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) HandleOrderGroup(ctx context.Context, in *OrderGroupMessage, opts ...grpc.CallOption) (*OrderGroupReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderGroupReport)
+	err := c.cc.Invoke(ctx, ExchangeService_HandleOrderGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) GetOrderStatus(ctx context.Context, in *OrderStatusRequest, opts ...grpc.CallOption) (*OrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderStatusResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_GetOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) CancelOwnOrders(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MassCancelResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_CancelOwnOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExchangeServiceServer is the server API for ExchangeService service.
 // All implementations must embed UnimplementedExchangeServiceServer
 // for forward compatibility.
 type ExchangeServiceServer interface {
-	HandleOrder(context.Context, *OrderMessage) (*OrderResponseMessage, error)
+	HandleOrder(context.Context, *OrderMessage) (*ExecutionReport, error)
 	SubscribeToOrderBook(*SubscribeRequest, grpc.ServerStreamingServer[OrderBookState]) error
+	SubscribeToExecutions(*ExecutionSubscribeRequest, grpc.ServerStreamingServer[ExecutionReport]) error
+	Retransmit(context.Context, *RetransmitRequest) (*RetransmitResponse, error)
+	GetSnapshot(context.Context, *SnapshotRequest) (*MarketDataMessage, error)
+	HandleOrderGroup(context.Context, *OrderGroupMessage) (*OrderGroupReport, error)
+	GetOrderStatus(context.Context, *OrderStatusRequest) (*OrderStatusResponse, error)
+	// Cancels the caller's own resting orders, participantId must be set
+	CancelOwnOrders(context.Context, *MassCancelRequest) (*MassCancelResponse, error)
 	mustEmbedUnimplementedExchangeServiceServer()
 }
 
@@ -84,12 +173,30 @@ type ExchangeServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedExchangeServiceServer struct{}
 
-func (UnimplementedExchangeServiceServer) HandleOrder(context.Context, *OrderMessage) (*OrderResponseMessage, error) {
+func (UnimplementedExchangeServiceServer) HandleOrder(context.Context, *OrderMessage) (*ExecutionReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleOrder not implemented")
 }
 func (UnimplementedExchangeServiceServer) SubscribeToOrderBook(*SubscribeRequest, grpc.ServerStreamingServer[OrderBookState]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToOrderBook not implemented")
 }
+func (UnimplementedExchangeServiceServer) SubscribeToExecutions(*ExecutionSubscribeRequest, grpc.ServerStreamingServer[ExecutionReport]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToExecutions not implemented")
+}
+func (UnimplementedExchangeServiceServer) Retransmit(context.Context, *RetransmitRequest) (*RetransmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retransmit not implemented")
+}
+func (UnimplementedExchangeServiceServer) GetSnapshot(context.Context, *SnapshotRequest) (*MarketDataMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedExchangeServiceServer) HandleOrderGroup(context.Context, *OrderGroupMessage) (*OrderGroupReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleOrderGroup not implemented")
+}
+func (UnimplementedExchangeServiceServer) GetOrderStatus(context.Context, *OrderStatusRequest) (*OrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatus not implemented")
+}
+func (UnimplementedExchangeServiceServer) CancelOwnOrders(context.Context, *MassCancelRequest) (*MassCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnOrders not implemented")
+}
 func (UnimplementedExchangeServiceServer) mustEmbedUnimplementedExchangeServiceServer() {}
 func (UnimplementedExchangeServiceServer) testEmbeddedByValue()                         {}
 
@@ -140,6 +247,107 @@ func _ExchangeService_SubscribeToOrderBook_Handler(srv interface{}, stream grpc.
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExchangeService_SubscribeToOrderBookServer = grpc.ServerStreamingServer[OrderBookState]
 
+func _ExchangeService_SubscribeToExecutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecutionSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeServiceServer).SubscribeToExecutions(m, &grpc.GenericServerStream[ExecutionSubscribeRequest, ExecutionReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExchangeService_SubscribeToExecutionsServer = grpc.ServerStreamingServer[ExecutionReport]
+
+func _ExchangeService_Retransmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetransmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).Retransmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_Retransmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).Retransmit(ctx, req.(*RetransmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_GetSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).GetSnapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_HandleOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderGroupMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).HandleOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_HandleOrderGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).HandleOrderGroup(ctx, req.(*OrderGroupMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_GetOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).GetOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_GetOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).GetOrderStatus(ctx, req.(*OrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_CancelOwnOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MassCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).CancelOwnOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_CancelOwnOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).CancelOwnOrders(ctx, req.(*MassCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExchangeService_ServiceDesc is the grpc.ServiceDesc for ExchangeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -151,6 +359,26 @@ var ExchangeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleOrder",
 			Handler:    _ExchangeService_HandleOrder_Handler,
 		},
+		{
+			MethodName: "Retransmit",
+			Handler:    _ExchangeService_Retransmit_Handler,
+		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _ExchangeService_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "HandleOrderGroup",
+			Handler:    _ExchangeService_HandleOrderGroup_Handler,
+		},
+		{
+			MethodName: "GetOrderStatus",
+			Handler:    _ExchangeService_GetOrderStatus_Handler,
+		},
+		{
+			MethodName: "CancelOwnOrders",
+			Handler:    _ExchangeService_CancelOwnOrders_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -158,6 +386,425 @@ var ExchangeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ExchangeService_SubscribeToOrderBook_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeToExecutions",
+			Handler:       _ExchangeService_SubscribeToExecutions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/exchange.proto",
+}
+
+const (
+	AdminService_SetSessionState_FullMethodName = "/exchange.AdminService/SetSessionState"
+	AdminService_GetSessionState_FullMethodName = "/exchange.AdminService/GetSessionState"
+	AdminService_ListSymbols_FullMethodName     = "/exchange.AdminService/ListSymbols"
+	AdminService_AddOrderBook_FullMethodName    = "/exchange.AdminService/AddOrderBook"
+	AdminService_DeleteOrderBook_FullMethodName = "/exchange.AdminService/DeleteOrderBook"
+	AdminService_HaltSymbol_FullMethodName      = "/exchange.AdminService/HaltSymbol"
+	AdminService_ResumeSymbol_FullMethodName    = "/exchange.AdminService/ResumeSymbol"
+	AdminService_MassCancel_FullMethodName      = "/exchange.AdminService/MassCancel"
+	AdminService_DumpOrderBook_FullMethodName   = "/exchange.AdminService/DumpOrderBook"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Operator controls, served next to the ExchangeService
+type AdminServiceClient interface {
+	SetSessionState(ctx context.Context, in *SessionStateRequest, opts ...grpc.CallOption) (*SessionStatus, error)
+	GetSessionState(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error)
+	ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...grpc.CallOption) (*SymbolList, error)
+	// sessionState is ignored, new books trade continuously
+	AddOrderBook(ctx context.Context, in *SymbolInfo, opts ...grpc.CallOption) (*SymbolInfo, error)
+	// Resting orders are cancelled and their owners sent a report first
+	DeleteOrderBook(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*MassCancelResponse, error)
+	HaltSymbol(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error)
+	ResumeSymbol(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error)
+	MassCancel(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelResponse, error)
+	DumpOrderBook(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*OrderBookDump, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SetSessionState(ctx context.Context, in *SessionStateRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, AdminService_SetSessionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetSessionState(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, AdminService_GetSessionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...grpc.CallOption) (*SymbolList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SymbolList)
+	err := c.cc.Invoke(ctx, AdminService_ListSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddOrderBook(ctx context.Context, in *SymbolInfo, opts ...grpc.CallOption) (*SymbolInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SymbolInfo)
+	err := c.cc.Invoke(ctx, AdminService_AddOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrderBook(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*MassCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MassCancelResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) HaltSymbol(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, AdminService_HaltSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeSymbol(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, AdminService_ResumeSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MassCancel(ctx context.Context, in *MassCancelRequest, opts ...grpc.CallOption) (*MassCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MassCancelResponse)
+	err := c.cc.Invoke(ctx, AdminService_MassCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DumpOrderBook(ctx context.Context, in *SymbolRequest, opts ...grpc.CallOption) (*OrderBookDump, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBookDump)
+	err := c.cc.Invoke(ctx, AdminService_DumpOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Operator controls, served next to the ExchangeService
+type AdminServiceServer interface {
+	SetSessionState(context.Context, *SessionStateRequest) (*SessionStatus, error)
+	GetSessionState(context.Context, *SymbolRequest) (*SessionStatus, error)
+	ListSymbols(context.Context, *ListSymbolsRequest) (*SymbolList, error)
+	// sessionState is ignored, new books trade continuously
+	AddOrderBook(context.Context, *SymbolInfo) (*SymbolInfo, error)
+	// Resting orders are cancelled and their owners sent a report first
+	DeleteOrderBook(context.Context, *SymbolRequest) (*MassCancelResponse, error)
+	HaltSymbol(context.Context, *SymbolRequest) (*SessionStatus, error)
+	ResumeSymbol(context.Context, *SymbolRequest) (*SessionStatus, error)
+	MassCancel(context.Context, *MassCancelRequest) (*MassCancelResponse, error)
+	DumpOrderBook(context.Context, *SymbolRequest) (*OrderBookDump, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SetSessionState(context.Context, *SessionStateRequest) (*SessionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionState not implemented")
+}
+func (UnimplementedAdminServiceServer) GetSessionState(context.Context, *SymbolRequest) (*SessionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionState not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSymbols(context.Context, *ListSymbolsRequest) (*SymbolList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSymbols not implemented")
+}
+func (UnimplementedAdminServiceServer) AddOrderBook(context.Context, *SymbolInfo) (*SymbolInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrderBook not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrderBook(context.Context, *SymbolRequest) (*MassCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrderBook not implemented")
+}
+func (UnimplementedAdminServiceServer) HaltSymbol(context.Context, *SymbolRequest) (*SessionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltSymbol not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeSymbol(context.Context, *SymbolRequest) (*SessionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSymbol not implemented")
+}
+func (UnimplementedAdminServiceServer) MassCancel(context.Context, *MassCancelRequest) (*MassCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MassCancel not implemented")
+}
+func (UnimplementedAdminServiceServer) DumpOrderBook(context.Context, *SymbolRequest) (*OrderBookDump, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpOrderBook not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetSessionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetSessionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetSessionState(ctx, req.(*SessionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSessionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetSessionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSessionState(ctx, req.(*SymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSymbols(ctx, req.(*ListSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddOrderBook(ctx, req.(*SymbolInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteOrderBook(ctx, req.(*SymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_HaltSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).HaltSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_HaltSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).HaltSymbol(ctx, req.(*SymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeSymbol(ctx, req.(*SymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MassCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MassCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MassCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MassCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MassCancel(ctx, req.(*MassCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DumpOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DumpOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DumpOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DumpOrderBook(ctx, req.(*SymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "exchange.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSessionState",
+			Handler:    _AdminService_SetSessionState_Handler,
+		},
+		{
+			MethodName: "GetSessionState",
+			Handler:    _AdminService_GetSessionState_Handler,
+		},
+		{
+			MethodName: "ListSymbols",
+			Handler:    _AdminService_ListSymbols_Handler,
+		},
+		{
+			MethodName: "AddOrderBook",
+			Handler:    _AdminService_AddOrderBook_Handler,
+		},
+		{
+			MethodName: "DeleteOrderBook",
+			Handler:    _AdminService_DeleteOrderBook_Handler,
+		},
+		{
+			MethodName: "HaltSymbol",
+			Handler:    _AdminService_HaltSymbol_Handler,
+		},
+		{
+			MethodName: "ResumeSymbol",
+			Handler:    _AdminService_ResumeSymbol_Handler,
+		},
+		{
+			MethodName: "MassCancel",
+			Handler:    _AdminService_MassCancel_Handler,
+		},
+		{
+			MethodName: "DumpOrderBook",
+			Handler:    _AdminService_DumpOrderBook_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/exchange.proto",
 }
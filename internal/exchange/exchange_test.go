@@ -0,0 +1,118 @@
+package exchange
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderCommands(t *testing.T) {
+	exchange := newTestExchange(t)
+	handleOrder := func(order *OrderMessage) (*ExecutionReport, error) {
+		return exchange.HandleOrder(context.Background(), order)
+	}
+	for _, order := range []*OrderMessage{
+		{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{Id: 2, OrderSide: Side_BID, Price: 99, Quantity: 10},
+		{Id: 4, OrderSide: Side_ASK, Price: 110, Quantity: 10},
+	} {
+		if _, err := handleOrder(order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := handleOrder(&OrderMessage{Command: Command_CANCEL, Id: 1, Quantity: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != OrderStatus_NEW || report.LeavesQuantity != 6 {
+		t.Fatalf("expected 6 left after cancelling 4, got %v", report)
+	}
+
+	report, err = handleOrder(&OrderMessage{Command: Command_REPLACE, Id: 1, NewId: 3, Price: 98})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.OrderId != 3 || report.LeavesQuantity != 6 {
+		t.Fatalf("expected order 3 with 6 left, got %v", report)
+	}
+	if _, err := exchange.getOrder(0, 1); err == nil {
+		t.Error("the replaced order is still resting")
+	}
+	if order, err := exchange.getOrder(0, 3); err != nil || order.GetPrice() != 98 {
+		t.Fatal("the replacement is not resting at 98")
+	}
+	// A replace without a new id keeps the order's id
+	if _, err := handleOrder(&OrderMessage{Command: Command_REPLACE, Id: 3, Price: 97}); err != nil {
+		t.Fatal(err)
+	}
+	if order, err := exchange.getOrder(0, 3); err != nil || order.GetPrice() != 97 {
+		t.Fatal("the order is not resting at 97")
+	}
+
+	report, err = handleOrder(&OrderMessage{Command: Command_DELETE, Id: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != OrderStatus_CANCELLED || report.LeavesQuantity != 0 {
+		t.Fatalf("expected order 2 cancelled, got %v", report)
+	}
+
+	for _, test := range []struct {
+		name  string
+		order *OrderMessage
+		code  codes.Code
+	}{
+		{"delete twice", &OrderMessage{Command: Command_DELETE, Id: 2}, codes.NotFound},
+		{"cancel unknown", &OrderMessage{Command: Command_CANCEL, Id: 1, Quantity: 1}, codes.NotFound},
+		{"cancel nothing", &OrderMessage{Command: Command_CANCEL, Id: 3}, codes.InvalidArgument},
+		{"replace unknown", &OrderMessage{Command: Command_REPLACE, Id: 1, Price: 100}, codes.NotFound},
+		{"replace onto a resting id", &OrderMessage{Command: Command_REPLACE, Id: 3, NewId: 4, Price: 100}, codes.AlreadyExists},
+	} {
+		if _, err := handleOrder(test.order); status.Code(err) != test.code {
+			t.Errorf("%s: expected %v, got %v", test.name, test.code, err)
+		}
+	}
+}
+
+// Reading the book walks its level iterators, readers at the same time must
+// not move them under each other
+func TestConcurrentBookReads(t *testing.T) {
+	exchange := newTestExchange(t)
+	for id := uint64(1); id <= 20; id++ {
+		order := &OrderMessage{Id: id, OrderSide: Side_BID, Price: id, Quantity: 1}
+		if id > 10 {
+			order.OrderSide, order.Price = Side_ASK, 100+id
+		}
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 100)
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if state := exchange.GetOrderBookState(0); len(state.Bids) != 10 || len(state.Asks) != 10 || state.Bids[0].Price != 10 || state.Asks[0].Price != 111 {
+				errs <- state.ObsToString()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			snapshot, err := exchange.GetSnapshot(context.Background(), &SnapshotRequest{SymbolId: 0})
+			if state := snapshot.GetSnapshot(); err != nil || len(state.Bids) != 10 || len(state.Asks) != 10 {
+				errs <- snapshot.String()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for state := range errs {
+		t.Errorf("expected 10 levels a side, got\n%s", state)
+	}
+}
@@ -64,11 +64,11 @@ func (ew *ExchangeUI) Run() {
 
 // layout defines the UI layout
 func (ew *ExchangeUI) layout(gtx layout.Context) layout.Dimensions {
-	// Lock the exchange while reading
-	ew.exchange.RLock()
+	// Printing the books moves their level iterators, so this takes the write lock
+	ew.exchange.Mu.Lock()
 	// Use the data directly from the exchange pointer
 	data := ew.exchange.String()
-	ew.exchange.RUnlock()
+	ew.exchange.Mu.Unlock()
 
 	// Create a simple label with the exchange data
 	label := material.H3(ew.th, data)
@@ -0,0 +1,68 @@
+package exchange
+
+import (
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/status"
+)
+
+// Builds the report for an order once the book is done with it, resting tells
+// us whether the order is still in the book. Only trades involving the order
+// become fills, the rest belong to any stop orders it activated.
+func newExecutionReport(order *ob.Order, resting bool, trades []ob.Trade) *ExecutionReport {
+	report := &ExecutionReport{
+		OrderId:            order.GetId(),
+		SymbolId:           order.GetSymbolId(),
+		ClientId:           order.GetClientId(),
+		CumulativeQuantity: order.GetExecutedQuantity(),
+		AveragePrice:       order.GetAverageExecutedPrice(),
+	}
+
+	switch {
+	case resting && order.GetExecutedQuantity() > 0:
+		report.Status = OrderStatus_PARTIALLY_FILLED
+	case resting:
+		report.Status = OrderStatus_NEW
+	case order.GetExecutedQuantity() == order.GetQuantity():
+		report.Status = OrderStatus_FILLED
+	default:
+		report.Status = OrderStatus_CANCELLED
+	}
+	if resting {
+		report.LeavesQuantity = order.GetOpenQuantity()
+	}
+
+	for i := range trades {
+		trade := &trades[i]
+		if trade.GetBidOrderId() != order.GetId() && trade.GetAskOrderId() != order.GetId() {
+			continue
+		}
+		report.Fills = append(report.Fills, &Fill{
+			TradeId:   trade.GetTradeId(),
+			Price:     trade.GetPrice(),
+			Quantity:  trade.GetQuantity(),
+			Aggressor: trade.GetAggressorOrderId() == order.GetId(),
+			Timestamp: trade.GetTimestamp(),
+		})
+	}
+	return report
+}
+
+func newRejectReport(orderMessage *OrderMessage, err error) *ExecutionReport {
+	return &ExecutionReport{
+		OrderId:      orderMessage.Id,
+		SymbolId:     orderMessage.SymbolId,
+		ClientId:     orderMessage.ClientId,
+		Status:       OrderStatus_REJECTED,
+		RejectReason: err.Error(),
+	}
+}
+
+// Same as toStatusError but with the rejected report attached as a status
+// detail, so clients get both the status code and the report
+func toRejectStatusError(orderMessage *OrderMessage, err error) error {
+	st := status.Convert(toStatusError(err))
+	if withReport, detailsErr := st.WithDetails(newRejectReport(orderMessage, err)); detailsErr == nil {
+		st = withReport
+	}
+	return st.Err()
+}
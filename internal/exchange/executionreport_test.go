@@ -0,0 +1,47 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExecutionReport(t *testing.T) {
+	exchange := newTestExchange(t)
+	handleOrder := func(order *OrderMessage) *ExecutionReport {
+		t.Helper()
+		report, err := exchange.HandleOrder(context.Background(), order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+	handleOrder(&OrderMessage{Id: 1, OrderSide: Side_ASK, Price: 100, Quantity: 3})
+	handleOrder(&OrderMessage{Id: 2, OrderSide: Side_ASK, Price: 101, Quantity: 3})
+
+	report := handleOrder(&OrderMessage{ClientId: "a", Id: 3, OrderSide: Side_BID, Price: 101, Quantity: 10})
+	if report.OrderId != 3 || report.ClientId != "a" || report.Status != OrderStatus_PARTIALLY_FILLED ||
+		report.CumulativeQuantity != 6 || report.LeavesQuantity != 4 || report.AveragePrice != 100.5 {
+		t.Fatalf("expected 6 filled at 100.5 with 4 left, got %v", report)
+	}
+	if len(report.Fills) != 2 || report.Fills[0].Price != 100 || report.Fills[1].Price != 101 ||
+		!report.Fills[0].Aggressor || report.Fills[0].TradeId == report.Fills[1].TradeId {
+		t.Fatalf("expected aggressive fills at 100 and 101, got %v", report.Fills)
+	}
+
+	report = handleOrder(&OrderMessage{Id: 4, OrderSide: Side_ASK, Price: 101, Quantity: 4})
+	if report.Status != OrderStatus_FILLED || report.LeavesQuantity != 0 || len(report.Fills) != 1 || report.Fills[0].Quantity != 4 {
+		t.Fatalf("expected order 4 filled against the resting bid, got %v", report)
+	}
+
+	// Whatever an immediate or cancel order cannot fill is cancelled
+	handleOrder(&OrderMessage{Id: 5, OrderSide: Side_ASK, Price: 102, Quantity: 2})
+	report = handleOrder(&OrderMessage{Id: 6, OrderSide: Side_BID, Price: 102, Quantity: 5, OrderTimeInForce: OrderTimeInForce_IOC})
+	if report.Status != OrderStatus_CANCELLED || report.CumulativeQuantity != 2 || report.LeavesQuantity != 0 {
+		t.Fatalf("expected 2 filled and the rest cancelled, got %v", report)
+	}
+
+	report = handleOrder(&OrderMessage{Id: 7, OrderSide: Side_BID, Price: 90, Quantity: 5})
+	if report.Status != OrderStatus_NEW || report.LeavesQuantity != 5 || len(report.Fills) != 0 {
+		t.Fatalf("expected a new resting order, got %v", report)
+	}
+}
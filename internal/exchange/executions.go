@@ -0,0 +1,179 @@
+package exchange
+
+import (
+	"log"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// Execution reports are never conflated like book updates, a report that
+// does not fit in the buffer is dropped and logged
+type ExecutionChannel struct {
+	ch chan *ExecutionReport
+}
+
+func NewExecutionChannel() *ExecutionChannel {
+	return &ExecutionChannel{
+		ch: make(chan *ExecutionReport, 256),
+	}
+}
+
+// executionHandler turns order book events into execution reports for the
+// session that owns each order. It runs inside the book so the exchange lock
+// is already held whenever it is called.
+type executionHandler struct {
+	ob.NullEventHandler
+	exchange *Exchange
+	// Trade reports waiting for OnTrade to fill in the trade id
+	pending []*ExecutionReport
+}
+
+func newExecutionHandler(exchange *Exchange) *executionHandler {
+	return &executionHandler{exchange: exchange}
+}
+
+func (handler *executionHandler) OnOrderUpdated(order *ob.Order, level *ob.Level) {
+	// Stops are activated outside of their level, trailing stops are re-priced
+	// into a new one and icebergs are replenished in theirs. Pegs that move are
+	// reported once they have joined their new level.
+	if level != nil && order.GetLevelPtr() != level {
+		return
+	}
+	executionType := ExecutionType_TRIGGERED
+	if level != nil {
+		executionType = ExecutionType_RESTATED
+	}
+	report := newOrderReport(order, executionType)
+	report.LeavesQuantity = order.GetOpenQuantity()
+	handler.send(order, report)
+}
+
+func (handler *executionHandler) OnOrderCancelled(order *ob.Order, level *ob.Level, quantity uint64) {
+	// Fully cancelled orders are reported once they are deleted
+	if order.IsFilled() {
+		return
+	}
+	report := newOrderReport(order, ExecutionType_CANCELLATION)
+	report.LeavesQuantity = order.GetOpenQuantity()
+	handler.send(order, report)
+}
+
+func (handler *executionHandler) OnOrderExecuted(order *ob.Order, level *ob.Level, price uint64, quantity uint64) {
+	if order.GetClientId() == "" {
+		return
+	}
+	report := newOrderReport(order, ExecutionType_TRADE)
+	report.LeavesQuantity = order.GetOpenQuantity()
+	if order.IsFilled() {
+		report.Status = OrderStatus_FILLED
+	} else {
+		report.Status = OrderStatus_PARTIALLY_FILLED
+	}
+	report.Fills = []*Fill{{Price: price, Quantity: quantity}}
+	handler.pending = append(handler.pending, report)
+}
+
+func (handler *executionHandler) OnOrderDeleted(order *ob.Order, level *ob.Level) {
+	// Filled orders were already reported by their last trade
+	if order.GetExecutedQuantity() == order.GetQuantity() {
+		return
+	}
+	handler.send(order, newCancellationReport(order))
+}
+
+// Sent before the copy is added, so any trades it makes follow the report
+func (handler *executionHandler) OnOrderReplaced(order *ob.Order, level *ob.Level, newOrder *ob.Order) {
+	report := newOrderReport(newOrder, ExecutionType_REPLACED)
+	report.LeavesQuantity = newOrder.GetOpenQuantity()
+	report.OrigOrderId = order.GetId()
+	handler.send(newOrder, report)
+}
+
+func (handler *executionHandler) OnTrade(trade *ob.Trade) {
+	for _, report := range handler.pending {
+		fill := report.Fills[0]
+		fill.TradeId = trade.GetTradeId()
+		fill.Aggressor = trade.GetAggressorOrderId() == report.OrderId
+		fill.Timestamp = trade.GetTimestamp()
+		handler.exchange.sendExecutionReport(report)
+	}
+	handler.pending = handler.pending[:0]
+}
+
+// Executions made directly on a resting order have no trade, their reports go
+// out without a trade id once the command is done
+func (handler *executionHandler) flush() {
+	for _, report := range handler.pending {
+		handler.exchange.sendExecutionReport(report)
+	}
+	handler.pending = handler.pending[:0]
+}
+
+func (handler *executionHandler) send(order *ob.Order, report *ExecutionReport) {
+	if order.GetClientId() == "" {
+		return
+	}
+	handler.exchange.sendExecutionReport(report)
+}
+
+// Report for a single event, the status is worked out from the order alone
+// as the book may be in the middle of moving it between levels
+func newOrderReport(order *ob.Order, executionType ExecutionType) *ExecutionReport {
+	report := &ExecutionReport{
+		OrderId:            order.GetId(),
+		SymbolId:           order.GetSymbolId(),
+		ClientId:           order.GetClientId(),
+		ExecutionType:      executionType,
+		CumulativeQuantity: order.GetExecutedQuantity(),
+		AveragePrice:       order.GetAverageExecutedPrice(),
+	}
+	if order.GetExecutedQuantity() > 0 {
+		report.Status = OrderStatus_PARTIALLY_FILLED
+	} else {
+		report.Status = OrderStatus_NEW
+	}
+	return report
+}
+
+// Report for an order that has left the book without filling
+func newCancellationReport(order *ob.Order) *ExecutionReport {
+	report := newOrderReport(order, ExecutionType_CANCELLATION)
+	report.Status = OrderStatus_CANCELLED
+	return report
+}
+
+func (exchange *Exchange) sendExecutionReport(report *ExecutionReport) {
+	value, ok := exchange.executionSessions.Load(report.ClientId)
+	if !ok {
+		return
+	}
+	select {
+	case value.(*ExecutionChannel).ch <- report:
+	default:
+		log.Printf("Execution buffer full for client %s, dropping report for order %d", report.ClientId, report.OrderId)
+	}
+}
+
+// SubscribeToExecutions implements ExchangeServiceServer.
+func (exchange *Exchange) SubscribeToExecutions(req *ExecutionSubscribeRequest, stream ExchangeService_SubscribeToExecutionsServer) error {
+	clientId := req.GetClientId()
+	if clientId == "" {
+		return toStatusError(ErrMissingClientId)
+	}
+
+	executionCh := NewExecutionChannel()
+	// A reconnecting client takes over the session from its old stream
+	exchange.executionSessions.Store(clientId, executionCh)
+	defer exchange.executionSessions.CompareAndDelete(clientId, executionCh)
+
+	for {
+		select {
+		case report := <-executionCh.ch:
+			if err := stream.Send(report); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
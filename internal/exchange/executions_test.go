@@ -0,0 +1,117 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reads every report streamed so far
+func drainReports(executionCh *ExecutionChannel) []*ExecutionReport {
+	reports := []*ExecutionReport{}
+	for {
+		select {
+		case report := <-executionCh.ch:
+			reports = append(reports, report)
+		default:
+			return reports
+		}
+	}
+}
+
+// Amends that requeue an order stream the order that rests, never a cancel
+func TestRequeueStreamsReplaced(t *testing.T) {
+	exchange := newTestExchange(t)
+	executionCh := NewExecutionChannel()
+	exchange.executionSessions.Store("a", executionCh)
+	handleOrder := func(order *OrderMessage) *ExecutionReport {
+		t.Helper()
+		report, err := exchange.HandleOrder(context.Background(), order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	handleOrder(&OrderMessage{ClientId: "a", Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10})
+	reply := handleOrder(&OrderMessage{ClientId: "a", Command: Command_MODIFY, Id: 1, Price: 101, Quantity: 10})
+	reports := drainReports(executionCh)
+	if len(reports) != 1 || reports[0].ExecutionType != ExecutionType_REPLACED || reports[0].OrderId != 1 ||
+		reports[0].Status != OrderStatus_NEW || reports[0].LeavesQuantity != reply.LeavesQuantity {
+		t.Fatalf("expected order 1 replaced with 10 left, got %v", reports)
+	}
+
+	reply = handleOrder(&OrderMessage{ClientId: "a", Command: Command_REPLACE, Id: 1, NewId: 2, Price: 102})
+	if reply.OrderId != 2 || reply.OrigOrderId != 1 {
+		t.Fatalf("expected the reply for order 2 replacing 1, got %v", reply)
+	}
+	reports = drainReports(executionCh)
+	if len(reports) != 1 || reports[0].ExecutionType != ExecutionType_REPLACED || reports[0].OrderId != 2 || reports[0].OrigOrderId != 1 {
+		t.Fatalf("expected order 2 reported as replacing 1, got %v", reports)
+	}
+
+	// A replace that trades streams the replacement before its fill
+	handleOrder(&OrderMessage{Id: 3, OrderSide: Side_ASK, Price: 105, Quantity: 4})
+	handleOrder(&OrderMessage{ClientId: "a", Command: Command_MODIFY, Id: 2, Price: 105, Quantity: 10})
+	reports = drainReports(executionCh)
+	if len(reports) != 2 || reports[0].ExecutionType != ExecutionType_REPLACED || reports[1].ExecutionType != ExecutionType_TRADE || reports[1].LeavesQuantity != 6 {
+		t.Fatalf("expected a replaced then a trade report, got %v", reports)
+	}
+}
+
+// Owners hear about fills made by someone else's order and about their stops
+func TestExecutionStream(t *testing.T) {
+	exchange := newTestExchange(t)
+	if err := exchange.SubscribeToExecutions(&ExecutionSubscribeRequest{}, nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a subscription without a client id to be refused, got %v", err)
+	}
+	executionCh := NewExecutionChannel()
+	exchange.executionSessions.Store("a", executionCh)
+	for _, order := range []*OrderMessage{
+		{ClientId: "a", Id: 1, OrderSide: Side_ASK, Price: 100, Quantity: 5},
+		{ClientId: "b", Id: 2, OrderSide: Side_BID, Price: 100, Quantity: 3},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reports := drainReports(executionCh)
+	if len(reports) != 1 || reports[0].OrderId != 1 || reports[0].ExecutionType != ExecutionType_TRADE ||
+		reports[0].Status != OrderStatus_PARTIALLY_FILLED || reports[0].LeavesQuantity != 2 {
+		t.Fatalf("expected a passive fill of order 1 with 2 left, got %v", reports)
+	}
+	if fill := reports[0].Fills[0]; fill.TradeId == 0 || fill.Aggressor || fill.Quantity != 3 {
+		t.Fatalf("expected a passive fill of 3 with its trade id, got %v", fill)
+	}
+
+	// The stop triggers on the trade at 101 and finds nothing left to buy
+	for _, order := range []*OrderMessage{
+		{ClientId: "a", Id: 3, OrderType: OrderType_STOP, OrderSide: Side_BID, StopPrice: 101, Quantity: 2},
+		{ClientId: "b", Id: 4, OrderSide: Side_ASK, Price: 101, Quantity: 1},
+		{ClientId: "b", Id: 5, OrderSide: Side_BID, Price: 101, Quantity: 3},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reports = drainReports(executionCh)
+	want := []struct {
+		orderId       uint64
+		executionType ExecutionType
+		status        OrderStatus
+	}{
+		{1, ExecutionType_TRADE, OrderStatus_FILLED},
+		{3, ExecutionType_TRIGGERED, OrderStatus_NEW},
+		{3, ExecutionType_CANCELLATION, OrderStatus_CANCELLED},
+	}
+	if len(reports) != len(want) {
+		t.Fatalf("expected %d reports, got %v", len(want), reports)
+	}
+	for i, report := range reports {
+		if report.OrderId != want[i].orderId || report.ExecutionType != want[i].executionType || report.Status != want[i].status {
+			t.Errorf("report %d is %v, want %+v", i, report, want[i])
+		}
+	}
+}
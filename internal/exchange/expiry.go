@@ -0,0 +1,97 @@
+package exchange
+
+import (
+	"time"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// Clock is where the exchange gets the time from, tests replace it to move
+// time forward without sleeping
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Time of day DAY orders expire at unless SetSessionClose is called
+const defaultSessionClose = 16 * time.Hour
+
+func (exchange *Exchange) SetClock(clock Clock) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	exchange.clock = clock
+}
+
+// SetSessionClose sets the time of day, in the clock's location, that DAY
+// orders expire at. Orders already resting keep their expire time.
+func (exchange *Exchange) SetSessionClose(timeOfDay time.Duration) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	exchange.sessionClose = timeOfDay
+}
+
+// First session close after now
+func (exchange *Exchange) nextSessionClose(now time.Time) time.Time {
+	year, month, day := now.Date()
+	sessionClose := time.Date(year, month, day, 0, 0, 0, 0, now.Location()).Add(exchange.sessionClose)
+	if !sessionClose.After(now) {
+		sessionClose = time.Date(year, month, day+1, 0, 0, 0, 0, now.Location()).Add(exchange.sessionClose)
+	}
+	return sessionClose
+}
+
+// DAY orders are given the next session close as their expire time, GTD
+// orders must not have expired already
+func (exchange *Exchange) setExpireTime(order *ob.Order) error {
+	now := exchange.clock.Now()
+	switch {
+	case order.IsDay():
+		order.SetExpireTime(exchange.nextSessionClose(now).UnixNano())
+	case order.IsGoodTillDate() && order.IsExpired(now.UnixNano()):
+		return &ob.OrderError{OrderId: order.GetId(), Err: ErrExpireTimeInPast}
+	}
+	return nil
+}
+
+// ExpireOrders cancels every DAY and GTD order whose expire time has been
+// reached by the exchange clock
+func (exchange *Exchange) ExpireOrders() {
+	exchange.Mu.Lock()
+	now := exchange.clock.Now().UnixNano()
+	symbolIds := []uint64{}
+	for symbolId, orderBook := range exchange.orderBooks {
+		if len(orderBook.ExpireOrders(now)) > 0 {
+			exchange.checkVolatilityAuction(symbolId)
+			symbolIds = append(symbolIds, symbolId)
+		}
+	}
+	exchange.executions.flush()
+	exchange.Mu.Unlock()
+
+	for _, symbolId := range symbolIds {
+		exchange.NotifyClients(symbolId)
+	}
+}
+
+// RunExpirySweeper expires orders and ends volatility auctions that have run
+// their course every interval of the exchange clock, it never returns
+func (exchange *Exchange) RunExpirySweeper(interval time.Duration) {
+	for {
+		exchange.Mu.RLock()
+		clock := exchange.clock
+		exchange.Mu.RUnlock()
+		<-clock.After(interval)
+		exchange.ExpireOrders()
+		exchange.EndVolatilityAuctions()
+	}
+}
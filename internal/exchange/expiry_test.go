@@ -0,0 +1,68 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Only moves when the test advances it
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
+
+func TestExpireOrders(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
+	exchange := NewExchange()
+	exchange.SetClock(clock)
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+
+	orders := []*OrderMessage{
+		{Id: 1, OrderSide: Side_BID, Price: 99, Quantity: 10, OrderTimeInForce: OrderTimeInForce_DAY},
+		{Id: 2, OrderSide: Side_BID, Price: 98, Quantity: 10, OrderTimeInForce: OrderTimeInForce_GTD, ExpireTime: clock.now.Add(time.Hour).UnixNano()},
+		{Id: 3, OrderSide: Side_BID, Price: 97, Quantity: 10},
+	}
+	for _, order := range orders {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := exchange.HandleOrder(context.Background(), &OrderMessage{
+		Id: 4, OrderSide: Side_BID, Price: 96, Quantity: 10, OrderTimeInForce: OrderTimeInForce_GTD, ExpireTime: clock.now.UnixNano(),
+	}); err == nil {
+		t.Fatal("expected a GTD order that has already expired to be rejected")
+	}
+
+	resting := func() []uint64 {
+		ids := []uint64{}
+		for _, id := range []uint64{1, 2, 3} {
+			if _, err := exchange.getOrder(0, id); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	exchange.ExpireOrders()
+	if ids := resting(); len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Fatalf("after the GTD deadline expected orders 1 and 3, got %v", ids)
+	}
+
+	// Session close is 16:00
+	clock.now = time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+	exchange.ExpireOrders()
+	if ids := resting(); len(ids) != 1 || ids[0] != 3 {
+		t.Fatalf("after the session close expected order 3, got %v", ids)
+	}
+}
@@ -0,0 +1,61 @@
+package exchange
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Exchange trading SPY as symbol 0
+func newTestExchange(t *testing.T) *Exchange {
+	t.Helper()
+	exchange := NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	return exchange
+}
+
+// Points the exchange's feed on channel 1 at a local socket, the returned
+// function reads every message broadcast since it was last called
+func listenToFeed(t *testing.T, exchange *Exchange) func() []*MarketDataMessage {
+	t.Helper()
+	listener, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := net.DialUDP("udp", nil, listener.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		listener.Close()
+	})
+	exchange.SetupBroadcaster(conn, 1)
+
+	buffer := make([]byte, 65536)
+	return func() []*MarketDataMessage {
+		t.Helper()
+		var messages []*MarketDataMessage
+		for {
+			listener.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+			n, err := listener.Read(buffer)
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return messages
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			message := &MarketDataMessage{}
+			if err := proto.Unmarshal(buffer[:n], message); err != nil {
+				t.Fatal(err)
+			}
+			messages = append(messages, message)
+		}
+	}
+}
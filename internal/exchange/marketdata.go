@@ -0,0 +1,456 @@
+package exchange
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/protobuf/proto"
+)
+
+// FeedMode selects what the multicast feed carries for each book
+type FeedMode int
+
+const (
+	// Aggregated level updates, the default
+	MarketByPrice FeedMode = iota
+	// One update per order add, execution and cancel
+	MarketByOrder
+)
+
+type levelKey struct {
+	side  ob.Side
+	price uint64
+}
+
+// marketDataPublisher follows one book's events and turns them into the
+// incremental feed. Events only mark levels as touched, the updates are worked
+// out by comparing touched levels against what was last published so a level
+// that changes several times in one command is sent once. In market by order
+// mode every order event is sent as it happened instead.
+type marketDataPublisher struct {
+	ob.NullEventHandler
+	symbolId       uint64
+	sequenceNumber uint64
+	mode           FeedMode
+	touched        map[levelKey]*ob.Level
+	published      map[levelKey]uint64
+	trades         []*TradeUpdate
+	// Order updates and trades in event order, market by order mode only
+	orderMessages []*MarketDataMessage
+	// Visible quantity last published for each resting order, market by order
+	// mode only. Hidden iceberg quantity is never shown.
+	orderQuantities map[uint64]uint64
+	// Indicative uncross waiting to be sent and the last one sent, only while
+	// the book is in an auction
+	pendingAuction   *AuctionUpdate
+	publishedAuction *AuctionUpdate
+	// Session state waiting to be sent, snapshots carry the current one
+	pendingSession *SessionStatus
+	sessionState   SessionState
+	priceScale     uint32
+	// Last sent messages kept for retransmission, indexed by sequence number
+	ring []*MarketDataMessage
+}
+
+// Number of messages per book that can be retransmitted
+const retransmitRingSize = 4096
+
+func newMarketDataPublisher(symbolId uint64, mode FeedMode) *marketDataPublisher {
+	return &marketDataPublisher{
+		symbolId:        symbolId,
+		mode:            mode,
+		touched:         make(map[levelKey]*ob.Level),
+		published:       make(map[levelKey]uint64),
+		orderQuantities: make(map[uint64]uint64),
+		ring:            make([]*MarketDataMessage, retransmitRingSize),
+	}
+}
+
+// Only limit orders live in the visible levels, resting stops are not shown
+func (publisher *marketDataPublisher) touch(order *ob.Order, level *ob.Level) {
+	if level == nil || !order.IsLimit() {
+		return
+	}
+	publisher.touched[levelKey{side: order.GetOrderSide(), price: level.GetPrice()}] = level
+}
+
+// Queues a market by order update, the quantity is the change in the order's
+// visible quantity since it was last published
+func (publisher *marketDataPublisher) orderUpdate(action OrderUpdateAction, order *ob.Order, level *ob.Level, remainingQuantity uint64) {
+	if level == nil || !order.IsLimit() {
+		return
+	}
+	previousQuantity := publisher.orderQuantities[order.GetId()]
+	quantity := previousQuantity - remainingQuantity
+	if action == OrderUpdateAction_ORDER_ADD {
+		quantity = remainingQuantity - previousQuantity
+	}
+	if quantity == 0 {
+		return
+	}
+	if remainingQuantity == 0 {
+		delete(publisher.orderQuantities, order.GetId())
+	} else {
+		publisher.orderQuantities[order.GetId()] = remainingQuantity
+	}
+	publisher.orderMessages = append(publisher.orderMessages, &MarketDataMessage{Body: &MarketDataMessage_OrderUpdate{OrderUpdate: &OrderUpdate{
+		Action:            action,
+		OrderId:           order.GetId(),
+		Side:              obToProtoSide(order.GetOrderSide()),
+		Price:             level.GetPrice(),
+		Quantity:          quantity,
+		RemainingQuantity: remainingQuantity,
+	}}})
+}
+
+func (publisher *marketDataPublisher) OnOrderAdded(order *ob.Order, level *ob.Level) {
+	if publisher.mode == MarketByOrder {
+		publisher.orderUpdate(OrderUpdateAction_ORDER_ADD, order, level, order.GetVisibleQuantity())
+		return
+	}
+	publisher.touch(order, level)
+}
+
+// Icebergs replenish by rejoining the back of their level, which market by
+// order shows as the order being added again. Pegs that move are updated on
+// the level they leave first, which market by order shows as a cancel.
+func (publisher *marketDataPublisher) OnOrderUpdated(order *ob.Order, level *ob.Level) {
+	if publisher.mode == MarketByOrder {
+		if level != nil && order.GetLevelPtr() != level {
+			publisher.orderUpdate(OrderUpdateAction_ORDER_CANCEL, order, level, 0)
+			return
+		}
+		publisher.orderUpdate(OrderUpdateAction_ORDER_ADD, order, level, order.GetVisibleQuantity())
+		return
+	}
+	publisher.touch(order, level)
+}
+
+func (publisher *marketDataPublisher) OnOrderCancelled(order *ob.Order, level *ob.Level, quantity uint64) {
+	if publisher.mode == MarketByOrder {
+		publisher.orderUpdate(OrderUpdateAction_ORDER_CANCEL, order, level, order.GetVisibleQuantity())
+		return
+	}
+	publisher.touch(order, level)
+}
+
+func (publisher *marketDataPublisher) OnOrderExecuted(order *ob.Order, level *ob.Level, price uint64, quantity uint64) {
+	if publisher.mode == MarketByOrder {
+		publisher.orderUpdate(OrderUpdateAction_ORDER_EXECUTE, order, level, order.GetVisibleQuantity())
+		return
+	}
+	publisher.touch(order, level)
+}
+
+// Filled orders already left the book with their last execution or cancel,
+// anything still open is cancelled outright
+func (publisher *marketDataPublisher) OnOrderDeleted(order *ob.Order, level *ob.Level) {
+	if publisher.mode == MarketByOrder {
+		publisher.orderUpdate(OrderUpdateAction_ORDER_CANCEL, order, level, 0)
+		return
+	}
+	publisher.touch(order, level)
+}
+
+// The feed shows the original leaving, its copy is added like any new order
+func (publisher *marketDataPublisher) OnOrderReplaced(order *ob.Order, level *ob.Level, newOrder *ob.Order) {
+	publisher.OnOrderDeleted(order, level)
+}
+
+func (publisher *marketDataPublisher) OnTrade(trade *ob.Trade) {
+	aggressorSide := Side_BID
+	if trade.GetAggressorSide() == ob.Ask {
+		aggressorSide = Side_ASK
+	}
+	update := &TradeUpdate{
+		TradeId:       trade.GetTradeId(),
+		Price:         trade.GetPrice(),
+		Quantity:      trade.GetQuantity(),
+		AggressorSide: aggressorSide,
+	}
+	if publisher.mode == MarketByOrder {
+		publisher.orderMessages = append(publisher.orderMessages, &MarketDataMessage{Body: &MarketDataMessage_Trade{Trade: update}})
+		return
+	}
+	publisher.trades = append(publisher.trades, update)
+}
+
+// Returns the messages since the last call. A session state change goes
+// first so the trades of an uncross follow the state they happened in, market
+// by price then sends trades and then the changed levels.
+func (publisher *marketDataPublisher) updates() []*MarketDataMessage {
+	var messages []*MarketDataMessage
+	if publisher.pendingSession != nil {
+		messages = append(messages, publisher.message(&MarketDataMessage{Body: &MarketDataMessage_SessionStatus{SessionStatus: publisher.pendingSession}}))
+		publisher.pendingSession = nil
+	}
+	for _, message := range publisher.orderMessages {
+		messages = append(messages, publisher.message(message))
+	}
+	publisher.orderMessages = nil
+
+	for _, trade := range publisher.trades {
+		messages = append(messages, publisher.message(&MarketDataMessage{Body: &MarketDataMessage_Trade{Trade: trade}}))
+	}
+	publisher.trades = nil
+
+	for key, level := range publisher.touched {
+		// Deleted levels are left empty so their volume drops to zero
+		quantity := level.GetVolume()
+		publishedQuantity, wasPublished := publisher.published[key]
+		update := &LevelUpdate{Side: obToProtoSide(key.side), Price: key.price, Quantity: quantity}
+		switch {
+		case quantity == 0 && !wasPublished:
+			continue
+		case quantity == 0:
+			update.Action = LevelUpdateAction_LEVEL_DELETE
+			delete(publisher.published, key)
+		case !wasPublished:
+			update.Action = LevelUpdateAction_LEVEL_ADD
+			publisher.published[key] = quantity
+		case quantity != publishedQuantity:
+			update.Action = LevelUpdateAction_LEVEL_MODIFY
+			publisher.published[key] = quantity
+		default:
+			continue
+		}
+		messages = append(messages, publisher.message(&MarketDataMessage{Body: &MarketDataMessage_LevelUpdate{LevelUpdate: update}}))
+	}
+	clear(publisher.touched)
+
+	if publisher.pendingAuction != nil {
+		messages = append(messages, publisher.message(&MarketDataMessage{Body: &MarketDataMessage_AuctionUpdate{AuctionUpdate: publisher.pendingAuction}}))
+		publisher.pendingAuction = nil
+	}
+	return messages
+}
+
+// Queues the indicative uncross if it changed since it was last sent
+func (publisher *marketDataPublisher) indicativeUncross(uncross ob.IndicativeUncross) {
+	update := &AuctionUpdate{
+		IndicativePrice:   uncross.GetPrice(),
+		IndicativeVolume:  uncross.GetVolume(),
+		ImbalanceQuantity: uncross.GetImbalance(),
+		ImbalanceSide:     obToProtoSide(uncross.GetImbalanceSide()),
+	}
+	if proto.Equal(update, publisher.publishedAuction) {
+		return
+	}
+	publisher.pendingAuction = update
+	publisher.publishedAuction = update
+}
+
+func (publisher *marketDataPublisher) sessionStatus(state ob.SessionState) {
+	publisher.sessionState = obToProtoSessionState(state)
+	publisher.pendingSession = &SessionStatus{State: publisher.sessionState}
+}
+
+// Full depth snapshot in the publisher's mode, must be taken right after
+// updates so it matches the last sequence number
+func (publisher *marketDataPublisher) snapshot(orderBook *ob.OrderBook) *MarketDataMessage {
+	message := &MarketDataMessage{
+		Header:    &PacketHeader{SymbolId: publisher.symbolId, SequenceNumber: publisher.sequenceNumber},
+		Timestamp: time.Now().UnixNano(),
+	}
+	if publisher.mode == MarketByOrder {
+		orderSnapshot := marketByOrderSnapshot(orderBook)
+		orderSnapshot.SessionState = publisher.sessionState
+		orderSnapshot.PriceScale = publisher.priceScale
+		message.Body = &MarketDataMessage_MarketByOrderSnapshot{MarketByOrderSnapshot: orderSnapshot}
+		return message
+	}
+	state := orderBookState(orderBook, math.MaxInt)
+	state.Timestamp = message.Timestamp
+	state.SessionState = publisher.sessionState
+	state.PriceScale = publisher.priceScale
+	message.Body = &MarketDataMessage_Snapshot{Snapshot: state}
+	return message
+}
+
+// Changes the mode, pending updates must have been published. The current
+// book is recorded as published so the new mode carries on from it.
+func (publisher *marketDataPublisher) setMode(mode FeedMode, orderBook *ob.OrderBook) {
+	publisher.mode = mode
+	clear(publisher.published)
+	clear(publisher.orderQuantities)
+	if mode == MarketByOrder {
+		for _, views := range [][]ob.OrderView{orderBook.GetTopNBidOrders(math.MaxInt), orderBook.GetTopNAskOrders(math.MaxInt)} {
+			for i := range views {
+				publisher.orderQuantities[views[i].GetOrderId()] = views[i].GetQuantity()
+			}
+		}
+		return
+	}
+	for _, level := range orderBook.GetTopNBids(math.MaxInt) {
+		publisher.published[levelKey{side: ob.Bid, price: level.GetPrice()}] = level.GetVolume()
+	}
+	for _, level := range orderBook.GetTopNAsks(math.MaxInt) {
+		publisher.published[levelKey{side: ob.Ask, price: level.GetPrice()}] = level.GetVolume()
+	}
+}
+
+func marketByOrderSnapshot(orderBook *ob.OrderBook) *MarketByOrderSnapshot {
+	return &MarketByOrderSnapshot{
+		Bids:              toRestingOrders(orderBook.GetTopNBidOrders(math.MaxInt)),
+		Asks:              toRestingOrders(orderBook.GetTopNAskOrders(math.MaxInt)),
+		LastExecutedPrice: orderBook.LastExecutedPriceAsk(),
+	}
+}
+
+func toRestingOrders(views []ob.OrderView) []*RestingOrder {
+	orders := make([]*RestingOrder, 0, len(views))
+	for i := range views {
+		orders = append(orders, &RestingOrder{
+			OrderId:       views[i].GetOrderId(),
+			Price:         views[i].GetPrice(),
+			Quantity:      views[i].GetQuantity(),
+			QueuePosition: uint32(views[i].GetQueuePosition()),
+		})
+	}
+	return orders
+}
+
+func (publisher *marketDataPublisher) message(message *MarketDataMessage) *MarketDataMessage {
+	publisher.sequenceNumber++
+	message.Header = &PacketHeader{SymbolId: publisher.symbolId, SequenceNumber: publisher.sequenceNumber}
+	message.Timestamp = time.Now().UnixNano()
+	return message
+}
+
+// Messages from start to end inclusive, nil if any of them have already been
+// overwritten or not sent yet
+func (publisher *marketDataPublisher) retransmit(start uint64, end uint64) []*MarketDataMessage {
+	if start == 0 || start > end || end > publisher.sequenceNumber || publisher.sequenceNumber-start >= retransmitRingSize {
+		return nil
+	}
+	messages := make([]*MarketDataMessage, 0, end-start+1)
+	for sequenceNumber := start; sequenceNumber <= end; sequenceNumber++ {
+		messages = append(messages, publisher.ring[sequenceNumber%retransmitRingSize])
+	}
+	return messages
+}
+
+func (exchange *Exchange) NotifyClients(symbolId uint64) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	publisher, exists := exchange.publishers[symbolId]
+	if !exists {
+		return
+	}
+	exchange.checkVolatilityAuction(symbolId)
+	exchange.publishUpdates(publisher)
+}
+
+// SetFeedMode switches every book's multicast feed, including books added
+// later. A snapshot in the new mode is sent straight away so consumers can
+// resync.
+func (exchange *Exchange) SetFeedMode(mode FeedMode) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	exchange.feedMode = mode
+	for symbolId, publisher := range exchange.publishers {
+		if publisher.mode == mode {
+			continue
+		}
+		exchange.publishUpdates(publisher)
+		publisher.setMode(mode, exchange.orderBooks[symbolId])
+		exchange.broadcast(publisher.snapshot(exchange.orderBooks[symbolId]))
+	}
+}
+
+// PublishSnapshots sends a full snapshot of every book each interval so late
+// joiners can sync, it never returns
+func (exchange *Exchange) PublishSnapshots(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		exchange.Mu.Lock()
+		for symbolId, publisher := range exchange.publishers {
+			// Anything not yet published has to go out first or the snapshot would skip it
+			exchange.publishUpdates(publisher)
+			exchange.broadcast(publisher.snapshot(exchange.orderBooks[symbolId]))
+		}
+		exchange.Mu.Unlock()
+	}
+}
+
+// Sends the book's pending updates and keeps them for retransmission
+func (exchange *Exchange) publishUpdates(publisher *marketDataPublisher) {
+	if orderBook, exists := exchange.orderBooks[publisher.symbolId]; exists && orderBook.IsInAuction() {
+		publisher.indicativeUncross(orderBook.GetIndicativeUncross())
+	}
+	exchange.sendUpdates(publisher)
+}
+
+// Same as publishUpdates without working out the indicative uncross
+func (exchange *Exchange) sendUpdates(publisher *marketDataPublisher) {
+	for _, message := range publisher.updates() {
+		publisher.ring[message.Header.SequenceNumber%retransmitRingSize] = message
+		exchange.broadcast(message)
+	}
+}
+
+// Retransmit implements ExchangeServiceServer.
+func (exchange *Exchange) Retransmit(ctx context.Context, req *RetransmitRequest) (*RetransmitResponse, error) {
+	exchange.Mu.RLock()
+	defer exchange.Mu.RUnlock()
+	publisher, exists := exchange.publishers[req.SymbolId]
+	if !exists {
+		return nil, toStatusError(newSymbolError(req.SymbolId, ErrUnknownSymbol))
+	}
+	messages := publisher.retransmit(req.FromSequenceNumber, req.ToSequenceNumber)
+	if messages == nil {
+		return nil, toStatusError(newSymbolError(req.SymbolId, ErrSequenceUnavailable))
+	}
+	return &RetransmitResponse{Messages: messages}, nil
+}
+
+// GetSnapshot implements ExchangeServiceServer.
+func (exchange *Exchange) GetSnapshot(ctx context.Context, req *SnapshotRequest) (*MarketDataMessage, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	publisher, exists := exchange.publishers[req.SymbolId]
+	if !exists {
+		return nil, toStatusError(newSymbolError(req.SymbolId, ErrUnknownSymbol))
+	}
+	exchange.publishUpdates(publisher)
+	snapshot := publisher.snapshot(exchange.orderBooks[req.SymbolId])
+	snapshot.Header.Channel = exchange.channel
+	return snapshot, nil
+}
+
+func (exchange *Exchange) broadcast(message *MarketDataMessage) {
+	message.Header.Channel = exchange.channel
+	if exchange.udpConn == nil {
+		return
+	}
+	data, err := proto.Marshal(message)
+	if err != nil {
+		log.Printf("Error marshaling market data: %v", err)
+		return
+	}
+	if _, err := exchange.udpConn.Write(data); err != nil {
+		log.Printf("Error broadcasting update: %v", err)
+	}
+}
+
+func obToProtoSide(side ob.Side) Side {
+	if side == ob.Ask {
+		return Side_ASK
+	}
+	return Side_BID
+}
+
+func protoToObEnumSide(side Side) (ob.Side, error) {
+	switch side {
+	case Side_BID:
+		return ob.Bid, nil
+	case Side_ASK:
+		return ob.Ask, nil
+	}
+	return ob.Bid, ErrInvalidSide
+}
@@ -0,0 +1,241 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMarketByPriceUpdates(t *testing.T) {
+	exchange := newTestExchange(t)
+	published := listenToFeed(t, exchange)
+	var sequenceNumber uint64
+
+	for _, test := range []struct {
+		name  string
+		order *OrderMessage
+		// Trade quantities then level updates, in the order they are sent
+		trades []uint64
+		levels []*LevelUpdate
+	}{
+		{
+			name:   "new level",
+			order:  &OrderMessage{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10},
+			levels: []*LevelUpdate{{Action: LevelUpdateAction_LEVEL_ADD, Side: Side_BID, Price: 100, Quantity: 10}},
+		},
+		{
+			name:   "joining a level",
+			order:  &OrderMessage{Id: 2, OrderSide: Side_BID, Price: 100, Quantity: 5},
+			levels: []*LevelUpdate{{Action: LevelUpdateAction_LEVEL_MODIFY, Side: Side_BID, Price: 100, Quantity: 15}},
+		},
+		{
+			name:   "nothing visible changes",
+			order:  &OrderMessage{Command: Command_MODIFY, Id: 2, Price: 100, Quantity: 5},
+			levels: nil,
+		},
+		{
+			// Both bids fill but the level is only sent once
+			name:   "sweeping a level",
+			order:  &OrderMessage{Id: 3, OrderSide: Side_ASK, Price: 100, Quantity: 15},
+			trades: []uint64{10, 5},
+			levels: []*LevelUpdate{{Action: LevelUpdateAction_LEVEL_DELETE, Side: Side_BID, Price: 100}},
+		},
+		{
+			name:   "new ask level",
+			order:  &OrderMessage{Id: 4, OrderSide: Side_ASK, Price: 101, Quantity: 5},
+			levels: []*LevelUpdate{{Action: LevelUpdateAction_LEVEL_ADD, Side: Side_ASK, Price: 101, Quantity: 5}},
+		},
+		{
+			name:   "partial fill",
+			order:  &OrderMessage{Id: 5, OrderSide: Side_BID, Price: 101, Quantity: 2},
+			trades: []uint64{2},
+			levels: []*LevelUpdate{{Action: LevelUpdateAction_LEVEL_MODIFY, Side: Side_ASK, Price: 101, Quantity: 3}},
+		},
+		{
+			name:   "a resting stop is not shown",
+			order:  &OrderMessage{Id: 6, OrderType: OrderType_STOP, OrderSide: Side_BID, StopPrice: 105, Quantity: 2},
+			levels: nil,
+		},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), test.order); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		messages := published()
+		if len(messages) != len(test.trades)+len(test.levels) {
+			t.Fatalf("%s: expected %d trades and %d level updates, got %v", test.name, len(test.trades), len(test.levels), messages)
+		}
+		for i, message := range messages {
+			sequenceNumber++
+			if message.Header.SequenceNumber != sequenceNumber || message.Header.SymbolId != 0 || message.Header.Channel != 1 {
+				t.Fatalf("%s: message %d has header %v, want sequence number %d", test.name, i, message.Header, sequenceNumber)
+			}
+			if i < len(test.trades) {
+				if trade := message.GetTrade(); trade == nil || trade.Quantity != test.trades[i] {
+					t.Errorf("%s: expected a trade of %d, got %v", test.name, test.trades[i], message)
+				}
+				continue
+			}
+			want := test.levels[i-len(test.trades)]
+			if update := message.GetLevelUpdate(); update == nil || update.Action != want.Action || update.Side != want.Side || update.Price != want.Price || update.Quantity != want.Quantity {
+				t.Errorf("%s: expected %v, got %v", test.name, want, message)
+			}
+		}
+	}
+
+	// Snapshots carry the whole book at the last sequence number sent
+	snapshot, err := exchange.GetSnapshot(context.Background(), &SnapshotRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := snapshot.GetSnapshot()
+	if snapshot.Header.SequenceNumber != sequenceNumber || state == nil {
+		t.Fatalf("expected a snapshot at %d, got %v", sequenceNumber, snapshot)
+	}
+	if len(state.Bids) != 0 || len(state.Asks) != 1 || state.Asks[0].Price != 101 || state.Asks[0].Quantity != 3 || state.LastExecutedPrice != 101 {
+		t.Errorf("expected 3 asked at 101 after a trade at 101, got %v", state)
+	}
+}
+
+func TestMarketByOrderUpdates(t *testing.T) {
+	exchange := NewExchange()
+	exchange.SetFeedMode(MarketByOrder)
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	published := listenToFeed(t, exchange)
+	var sequenceNumber uint64
+
+	for _, test := range []struct {
+		name  string
+		order *OrderMessage
+		// Order updates and trades in the order they happened, trades have no
+		// action and are matched on quantity alone
+		want []*OrderUpdate
+	}{
+		{
+			name:  "add",
+			order: &OrderMessage{Id: 1, OrderSide: Side_ASK, Price: 101, Quantity: 10},
+			want:  []*OrderUpdate{{Action: OrderUpdateAction_ORDER_ADD, OrderId: 1, Side: Side_ASK, Price: 101, Quantity: 10, RemainingQuantity: 10}},
+		},
+		{
+			name:  "queued behind",
+			order: &OrderMessage{Id: 2, OrderSide: Side_ASK, Price: 101, Quantity: 5},
+			want:  []*OrderUpdate{{Action: OrderUpdateAction_ORDER_ADD, OrderId: 2, Side: Side_ASK, Price: 101, Quantity: 5, RemainingQuantity: 5}},
+		},
+		{
+			name:  "partial cancel",
+			order: &OrderMessage{Command: Command_CANCEL, Id: 1, Quantity: 4},
+			want:  []*OrderUpdate{{Action: OrderUpdateAction_ORDER_CANCEL, OrderId: 1, Side: Side_ASK, Price: 101, Quantity: 4, RemainingQuantity: 6}},
+		},
+		{
+			// The aggressor never rests so only the resting orders are shown
+			name:  "sweep",
+			order: &OrderMessage{Id: 3, OrderSide: Side_BID, Price: 101, Quantity: 8},
+			want: []*OrderUpdate{
+				{Action: OrderUpdateAction_ORDER_EXECUTE, OrderId: 1, Side: Side_ASK, Price: 101, Quantity: 6},
+				{Quantity: 6},
+				{Action: OrderUpdateAction_ORDER_EXECUTE, OrderId: 2, Side: Side_ASK, Price: 101, Quantity: 2, RemainingQuantity: 3},
+				{Quantity: 2},
+			},
+		},
+		{
+			name:  "delete",
+			order: &OrderMessage{Command: Command_DELETE, Id: 2},
+			want:  []*OrderUpdate{{Action: OrderUpdateAction_ORDER_CANCEL, OrderId: 2, Side: Side_ASK, Price: 101, Quantity: 3}},
+		},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), test.order); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		messages := published()
+		if len(messages) != len(test.want) {
+			t.Fatalf("%s: expected %d messages, got %v", test.name, len(test.want), messages)
+		}
+		for i, message := range messages {
+			sequenceNumber++
+			if message.Header.SequenceNumber != sequenceNumber {
+				t.Fatalf("%s: message %d has sequence number %d, want %d", test.name, i, message.Header.SequenceNumber, sequenceNumber)
+			}
+			want := test.want[i]
+			if want.OrderId == 0 {
+				if trade := message.GetTrade(); trade == nil || trade.Quantity != want.Quantity {
+					t.Errorf("%s: expected a trade of %d, got %v", test.name, want.Quantity, message)
+				}
+				continue
+			}
+			update := message.GetOrderUpdate()
+			if update == nil || update.Action != want.Action || update.OrderId != want.OrderId || update.Side != want.Side ||
+				update.Price != want.Price || update.Quantity != want.Quantity || update.RemainingQuantity != want.RemainingQuantity {
+				t.Errorf("%s: expected %v, got %v", test.name, want, message)
+			}
+		}
+	}
+
+	// The snapshot shows each order where it sits in its queue
+	for _, order := range []*OrderMessage{
+		{Id: 4, OrderSide: Side_BID, Price: 99, Quantity: 1},
+		{Id: 5, OrderSide: Side_BID, Price: 100, Quantity: 2},
+		{Id: 6, OrderSide: Side_BID, Price: 99, Quantity: 3},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snapshot, err := exchange.GetSnapshot(context.Background(), &SnapshotRequest{SymbolId: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bids := snapshot.GetMarketByOrderSnapshot().GetBids()
+	want := []*RestingOrder{
+		{OrderId: 5, Price: 100, Quantity: 2, QueuePosition: 0},
+		{OrderId: 4, Price: 99, Quantity: 1, QueuePosition: 0},
+		{OrderId: 6, Price: 99, Quantity: 3, QueuePosition: 1},
+	}
+	if len(bids) != len(want) {
+		t.Fatalf("expected %d resting bids, got %v", len(want), snapshot)
+	}
+	for i := range bids {
+		if bids[i].OrderId != want[i].OrderId || bids[i].Price != want[i].Price || bids[i].Quantity != want[i].Quantity || bids[i].QueuePosition != want[i].QueuePosition {
+			t.Errorf("expected %v, got %v", want[i], bids[i])
+		}
+	}
+}
+
+// Neither feed gives away an iceberg's hidden reserve
+func TestIcebergFeeds(t *testing.T) {
+	for _, mode := range []FeedMode{MarketByPrice, MarketByOrder} {
+		exchange := NewExchange()
+		exchange.SetFeedMode(mode)
+		if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+			t.Fatal(err)
+		}
+		published := listenToFeed(t, exchange)
+		for _, order := range []*OrderMessage{
+			{Id: 1, OrderSide: Side_ASK, Price: 101, Quantity: 100, DisplayQuantity: 10},
+			// Takes the first slice and part of the next
+			{Id: 2, OrderSide: Side_BID, Price: 101, Quantity: 14},
+		} {
+			if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var shown uint64
+		for _, message := range published() {
+			if update := message.GetLevelUpdate(); update != nil {
+				shown = update.Quantity
+			}
+			if update := message.GetOrderUpdate(); update != nil {
+				shown = update.RemainingQuantity
+			}
+			if shown > 10 {
+				t.Fatalf("mode %d showed %d of the iceberg in %v", mode, shown, message)
+			}
+		}
+		if shown != 6 {
+			t.Errorf("mode %d: expected the last update to show 6, got %d", mode, shown)
+		}
+		state := exchange.GetOrderBookState(0)
+		if len(state.Asks) != 1 || state.Asks[0].Quantity != 6 {
+			t.Errorf("mode %d: expected 6 shown at 101, got %v", mode, state.Asks)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package exchange
+
+import (
+	"context"
+	"sort"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// MassCancel pulls every resting order in scope whatever the session state,
+// owners are sent a cancellation report for each. Scopes without a symbol
+// cover every book. Returns the cancelled orders by symbol then id.
+func (exchange *Exchange) MassCancel(scope ob.MassCancelScope) ([]*ob.Order, error) {
+	return exchange.massCancelSymbols(scope, false)
+}
+
+// CancelOwnOrders implements ExchangeServiceServer. It is the mass cancel
+// participants send for their own orders, so the participant id is required
+// and, like a DELETE, it is refused by books whose session state does not take
+// cancels. Such books are skipped when the request covers every symbol.
+func (exchange *Exchange) CancelOwnOrders(ctx context.Context, req *MassCancelRequest) (*MassCancelResponse, error) {
+	if req.ParticipantId == 0 {
+		return nil, toStatusError(ErrMissingParticipantId)
+	}
+	scope, err := protoToObMassCancelScope(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	cancelled, err := exchange.massCancelSymbols(scope, true)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &MassCancelResponse{Reports: cancellationReports(cancelled)}, nil
+}
+
+func (exchange *Exchange) massCancelSymbols(scope ob.MassCancelScope, sessionRules bool) ([]*ob.Order, error) {
+	exchange.Mu.Lock()
+	symbolIds := []uint64{}
+	if symbolId, bySymbol := scope.GetSymbolId(); bySymbol {
+		if err := exchange.checkOrderbookExists(symbolId); err != nil {
+			exchange.Mu.Unlock()
+			return nil, err
+		}
+		if sessionRules {
+			if err := exchange.checkCommandAllowed(symbolId, Command_DELETE); err != nil {
+				exchange.Mu.Unlock()
+				return nil, err
+			}
+		}
+		symbolIds = append(symbolIds, symbolId)
+	} else {
+		for symbolId := range exchange.orderBooks {
+			if sessionRules && exchange.checkCommandAllowed(symbolId, Command_DELETE) != nil {
+				continue
+			}
+			symbolIds = append(symbolIds, symbolId)
+		}
+		sort.Slice(symbolIds, func(i, j int) bool { return symbolIds[i] < symbolIds[j] })
+	}
+
+	cancelled := []*ob.Order{}
+	changed := []uint64{}
+	for _, symbolId := range symbolIds {
+		orders := exchange.massCancel(symbolId, scope)
+		if len(orders) > 0 {
+			changed = append(changed, symbolId)
+		}
+		cancelled = append(cancelled, orders...)
+	}
+	exchange.Mu.Unlock()
+
+	for _, symbolId := range changed {
+		exchange.NotifyClients(symbolId)
+	}
+	return cancelled, nil
+}
+
+// Must be called with the lock held and after the book has been checked
+func (exchange *Exchange) massCancel(symbolId uint64, scope ob.MassCancelScope) []*ob.Order {
+	cancelled := exchange.orderBooks[symbolId].MassCancel(scope)
+	exchange.checkVolatilityAuction(symbolId)
+	exchange.executions.flush()
+	return cancelled
+}
+
+func protoToObMassCancelScope(req *MassCancelRequest) (ob.MassCancelScope, error) {
+	scope := ob.AllOrders()
+	if !req.AllSymbols {
+		scope = scope.WithSymbol(req.SymbolId)
+	}
+	if req.ParticipantId != 0 {
+		scope = scope.WithParticipant(req.ParticipantId)
+	}
+	if req.OneSide {
+		side, err := protoToObEnumSide(req.Side)
+		if err != nil {
+			return scope, err
+		}
+		scope = scope.WithSide(side)
+	}
+	return scope, nil
+}
+
+func cancellationReports(orders []*ob.Order) []*ExecutionReport {
+	reports := make([]*ExecutionReport, 0, len(orders))
+	for _, order := range orders {
+		reports = append(reports, newCancellationReport(order))
+	}
+	return reports
+}
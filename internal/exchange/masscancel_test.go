@@ -0,0 +1,88 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMassCancelAcrossSymbols(t *testing.T) {
+	exchange := NewExchange()
+	for symbolId, ticker := range []string{"SPY", "QQQ"} {
+		if err := exchange.AddOrderbook(uint64(symbolId), ticker); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, order := range []*OrderMessage{
+		{SymbolId: 0, Id: 1, ParticipantId: 7, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{SymbolId: 0, Id: 2, ParticipantId: 8, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{SymbolId: 1, Id: 3, ParticipantId: 7, OrderSide: Side_BID, Price: 50, Quantity: 10},
+		{SymbolId: 1, Id: 4, ParticipantId: 7, OrderSide: Side_ASK, Price: 51, Quantity: 10},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	admin := NewAdminServer(exchange)
+
+	if _, err := admin.MassCancel(context.Background(), &MassCancelRequest{SymbolId: 2}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown symbol to be refused, got %v", err)
+	}
+	// Participant 7's bids on every book
+	response, err := admin.MassCancel(context.Background(), &MassCancelRequest{AllSymbols: true, ParticipantId: 7, OneSide: true, Side: Side_BID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Reports) != 2 || response.Reports[0].OrderId != 1 || response.Reports[1].OrderId != 3 {
+		t.Fatalf("expected orders 1 and 3 cancelled, got %v", response.Reports)
+	}
+	for _, resting := range []struct{ symbolId, orderId uint64 }{{0, 2}, {1, 4}} {
+		if _, err := exchange.getOrder(resting.symbolId, resting.orderId); err != nil {
+			t.Errorf("order %d outside the scope was cancelled", resting.orderId)
+		}
+	}
+}
+
+// Participants only reach their own orders, in books that take cancels
+func TestCancelOwnOrders(t *testing.T) {
+	exchange := NewExchange()
+	for symbolId, ticker := range []string{"SPY", "QQQ"} {
+		if err := exchange.AddOrderbook(uint64(symbolId), ticker); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, order := range []*OrderMessage{
+		{SymbolId: 0, Id: 1, ParticipantId: 7, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{SymbolId: 0, Id: 2, ParticipantId: 8, OrderSide: Side_BID, Price: 100, Quantity: 10},
+		{SymbolId: 1, Id: 3, ParticipantId: 7, OrderSide: Side_BID, Price: 50, Quantity: 10},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := exchange.SetSessionState(1, ob.Closed); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := exchange.CancelOwnOrders(context.Background(), &MassCancelRequest{AllSymbols: true}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a request without a participant to be refused, got %v", err)
+	}
+	if _, err := exchange.CancelOwnOrders(context.Background(), &MassCancelRequest{SymbolId: 1, ParticipantId: 7}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a closed book to refuse the cancel, got %v", err)
+	}
+	response, err := exchange.CancelOwnOrders(context.Background(), &MassCancelRequest{AllSymbols: true, ParticipantId: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Reports) != 1 || response.Reports[0].OrderId != 1 || response.Reports[0].Status != OrderStatus_CANCELLED {
+		t.Fatalf("expected only order 1 cancelled, got %v", response.Reports)
+	}
+	for _, resting := range []struct{ symbolId, orderId uint64 }{{0, 2}, {1, 3}} {
+		if _, err := exchange.getOrder(resting.symbolId, resting.orderId); err != nil {
+			t.Errorf("order %d should still rest", resting.orderId)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// HandleOrderGroup implements ExchangeServiceServer.
+func (exchange *Exchange) HandleOrderGroup(ctx context.Context, groupMessage *OrderGroupMessage) (*OrderGroupReport, error) {
+	report, err := exchange.handleOrderGroupMessage(groupMessage)
+	if err != nil {
+		if len(groupMessage.Orders) == 0 {
+			return nil, toStatusError(err)
+		}
+		return nil, toRejectStatusError(rejectedGroupOrder(groupMessage, err), err)
+	}
+	exchange.NotifyClients(groupMessage.Orders[0].SymbolId)
+	return report, nil
+}
+
+// The order the error is about, or the group's first when it is about the
+// whole group
+func rejectedGroupOrder(groupMessage *OrderGroupMessage, err error) *OrderMessage {
+	var orderErr *ob.OrderError
+	if errors.As(err, &orderErr) {
+		for _, orderMessage := range groupMessage.Orders {
+			if orderMessage.Id == orderErr.OrderId {
+				return orderMessage
+			}
+		}
+	}
+	return groupMessage.Orders[0]
+}
+
+func (exchange *Exchange) handleOrderGroupMessage(groupMessage *OrderGroupMessage) (*OrderGroupReport, error) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+
+	groupType, err := protoToObEnumOrderGroupType(groupMessage.Type)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupMessage.Orders) == 0 {
+		return nil, ob.ErrInvalidOrderGroup
+	}
+	symbolId := groupMessage.Orders[0].SymbolId
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	if err := exchange.checkCommandAllowed(symbolId, Command_ADD); err != nil {
+		return nil, err
+	}
+	orders := make([]*ob.Order, 0, len(groupMessage.Orders))
+	for _, orderMessage := range groupMessage.Orders {
+		if orderMessage.Command != Command_ADD || orderMessage.SymbolId != symbolId {
+			return nil, &ob.OrderError{OrderId: orderMessage.Id, Err: ob.ErrInvalidOrderGroup}
+		}
+		order, err := createOrderFromMessage(orderMessage)
+		if err != nil {
+			return nil, err
+		}
+		if err := exchange.setExpireTime(order); err != nil {
+			return nil, err
+		}
+		if err := exchange.checkReferenceData(order); err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	orderBook := exchange.orderBooks[symbolId]
+	trades, err := orderBook.AddOrderGroup(groupMessage.GroupId, groupType, orders)
+	exchange.checkVolatilityAuction(symbolId)
+	if err != nil {
+		return nil, err
+	}
+	group, _ := orderBook.GetOrderGroup(orders[0].GetId())
+	report := &OrderGroupReport{Group: newOrderGroupStatus(group)}
+	for _, orderId := range group.GetOrderIds() {
+		report.Reports = append(report.Reports, groupOrderReport(orderBook, group, orderId, trades))
+	}
+	return report, nil
+}
+
+// GetOrderStatus implements ExchangeServiceServer. Orders are known while they
+// rest in the book, grouped orders until their finished group is forgotten.
+func (exchange *Exchange) GetOrderStatus(ctx context.Context, req *OrderStatusRequest) (*OrderStatusResponse, error) {
+	exchange.Mu.RLock()
+	defer exchange.Mu.RUnlock()
+	if err := exchange.checkOrderbookExists(req.SymbolId); err != nil {
+		return nil, toStatusError(err)
+	}
+	orderBook := exchange.orderBooks[req.SymbolId]
+	if group, grouped := orderBook.GetOrderGroup(req.OrderId); grouped {
+		return &OrderStatusResponse{
+			Report: groupOrderReport(orderBook, group, req.OrderId, nil),
+			Group:  newOrderGroupStatus(group),
+		}, nil
+	}
+	order, resting := orderBook.GetOrder(req.OrderId)
+	if !resting {
+		return nil, toStatusError(&ob.OrderError{OrderId: req.OrderId, Err: ob.ErrUnknownOrder})
+	}
+	return &OrderStatusResponse{Report: newExecutionReport(order, true, nil)}, nil
+}
+
+// Children waiting on their parent report PENDING_NEW with all of their
+// quantity still to go
+func groupOrderReport(orderBook *ob.OrderBook, group *ob.OrderGroup, orderId uint64, trades []ob.Trade) *ExecutionReport {
+	order, _ := group.GetOrder(orderId)
+	if group.IsPending(orderId) {
+		report := newExecutionReport(order, false, nil)
+		report.Status = OrderStatus_PENDING_NEW
+		report.LeavesQuantity = order.GetOpenQuantity()
+		return report
+	}
+	_, resting := orderBook.GetOrder(orderId)
+	return newExecutionReport(order, resting, trades)
+}
+
+func newOrderGroupStatus(group *ob.OrderGroup) *OrderGroupStatus {
+	return &OrderGroupStatus{
+		GroupId:  group.GetGroupId(),
+		Type:     obToProtoOrderGroupType(group.GetGroupType()),
+		State:    obToProtoOrderGroupState(group.GetState()),
+		OrderIds: group.GetOrderIds(),
+	}
+}
+
+func protoToObEnumOrderGroupType(protoGroupType OrderGroupType) (ob.OrderGroupType, error) {
+	switch protoGroupType {
+	case OrderGroupType_OCO:
+		return ob.OneCancelsOther, nil
+	case OrderGroupType_OTO:
+		return ob.OneTriggersOther, nil
+	case OrderGroupType_BRACKET:
+		return ob.Bracket, nil
+	}
+	return ob.OneCancelsOther, ob.ErrInvalidOrderGroup
+}
+
+func obToProtoOrderGroupType(groupType ob.OrderGroupType) OrderGroupType {
+	switch groupType {
+	case ob.OneTriggersOther:
+		return OrderGroupType_OTO
+	case ob.Bracket:
+		return OrderGroupType_BRACKET
+	}
+	return OrderGroupType_OCO
+}
+
+func obToProtoOrderGroupState(groupState ob.OrderGroupState) OrderGroupState {
+	switch groupState {
+	case ob.GroupActive:
+		return OrderGroupState_GROUP_ACTIVE
+	case ob.GroupCompleted:
+		return OrderGroupState_GROUP_COMPLETED
+	case ob.GroupCancelled:
+		return OrderGroupState_GROUP_CANCELLED
+	}
+	return OrderGroupState_GROUP_PENDING
+}
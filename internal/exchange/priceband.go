@@ -0,0 +1,63 @@
+package exchange
+
+import (
+	"time"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// Time a volatility auction runs for unless SetVolatilityAuctionPeriod is called
+const defaultVolatilityAuctionPeriod = 5 * time.Minute
+
+// SetPriceBands replaces the static band and dynamic collar of a book
+func (exchange *Exchange) SetPriceBands(symbolId uint64, priceBands ob.PriceBands) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
+	exchange.orderBooks[symbolId].SetPriceBands(priceBands)
+	return nil
+}
+
+// SetVolatilityAuctionPeriod sets how long books stay in a volatility auction,
+// auctions already running keep their end time
+func (exchange *Exchange) SetVolatilityAuctionPeriod(period time.Duration) {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	exchange.volatilityAuctionPeriod = period
+}
+
+// Moves a continuously trading symbol whose book hit its collar into a
+// volatility auction. Must be called with the lock held once the command that
+// traded is done, anything it published so far goes out before the new state.
+func (exchange *Exchange) checkVolatilityAuction(symbolId uint64) {
+	orderBook := exchange.orderBooks[symbolId]
+	if !orderBook.IsInVolatilityAuction() || exchange.symbolMap[symbolId].GetSessionState() != ob.Continuous {
+		return
+	}
+	exchange.sendUpdates(exchange.publishers[symbolId])
+	// The book is already in its auction so the move cannot fail
+	exchange.setSessionState(symbolId, ob.VolatilityAuction)
+}
+
+// EndVolatilityAuctions uncrosses every book whose volatility auction has run
+// for its period and returns it to continuous trading
+func (exchange *Exchange) EndVolatilityAuctions() {
+	exchange.Mu.Lock()
+	now := exchange.clock.Now()
+	symbolIds := []uint64{}
+	for symbolId, end := range exchange.volatilityAuctionEnds {
+		if now.Before(end) {
+			continue
+		}
+		if _, err := exchange.setSessionState(symbolId, ob.Continuous); err == nil {
+			symbolIds = append(symbolIds, symbolId)
+		}
+	}
+	exchange.Mu.Unlock()
+
+	for _, symbolId := range symbolIds {
+		exchange.NotifyClients(symbolId)
+	}
+}
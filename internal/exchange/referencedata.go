@@ -0,0 +1,60 @@
+package exchange
+
+import (
+	"errors"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+)
+
+// SetReferenceData replaces a symbol's reference data, orders already
+// resting are not checked against it
+func (exchange *Exchange) SetReferenceData(symbolId uint64, referenceData ob.ReferenceData) error {
+	exchange.Mu.Lock()
+	defer exchange.Mu.Unlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return err
+	}
+	exchange.applyReferenceData(symbolId, referenceData)
+	return nil
+}
+
+// The book reprices pegs and post only orders in ticks and the feed shows
+// prices at the symbol's scale
+func (exchange *Exchange) applyReferenceData(symbolId uint64, referenceData ob.ReferenceData) {
+	exchange.symbolMap[symbolId].SetReferenceData(referenceData)
+	exchange.orderBooks[symbolId].SetTickSize(referenceData.GetTickSize())
+	exchange.publishers[symbolId].priceScale = referenceData.GetPriceScale()
+}
+
+// Orders without a fixed price have their notional checked at the book's
+// market price for their side
+func (exchange *Exchange) checkReferenceData(order *ob.Order) error {
+	symbolId := order.GetSymbolId()
+	referenceData := exchange.symbolMap[symbolId].GetReferenceData()
+	return referenceData.CheckOrder(order, exchange.orderBooks[symbolId].GetMarketPrice(order.GetOrderSide()))
+}
+
+// Amendments are held to the tick, lot and notional limits, a zero quantity
+// keeps the open quantity. The new open quantity can be below the minimum as
+// the order may have partly filled. Unknown orders are left to the book.
+func (exchange *Exchange) checkAmendment(symbolId uint64, orderId uint64, newPrice uint64, newQuantity uint64) error {
+	order, exists := exchange.orderBooks[symbolId].GetOrder(orderId)
+	if !exists {
+		return nil
+	}
+	referenceData := exchange.symbolMap[symbolId].GetReferenceData()
+	if !order.IsPegged() {
+		if err := referenceData.CheckPrice(newPrice); err != nil {
+			return &ob.OrderError{OrderId: orderId, Err: err}
+		}
+	}
+	if newQuantity == 0 {
+		newQuantity = order.GetOpenQuantity()
+	} else if err := referenceData.CheckQuantity(newQuantity); err != nil && !errors.Is(err, ob.ErrQuantityBelowMinimum) {
+		return &ob.OrderError{OrderId: orderId, Err: err}
+	}
+	if err := referenceData.CheckNotional(newPrice, newQuantity); err != nil {
+		return &ob.OrderError{OrderId: orderId, Err: err}
+	}
+	return nil
+}
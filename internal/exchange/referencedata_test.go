@@ -0,0 +1,89 @@
+package exchange
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReferenceDataChecks(t *testing.T) {
+	exchange := NewExchange()
+	// Ticks of 5, lots of 10 between 10 and 1000, prices in cents
+	referenceData, err := ob.NewReferenceData(5, 10, 10, 1000, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exchange.AddOrderbookWithReferenceData(0, "SPY", referenceData); err != nil {
+		t.Fatal(err)
+	}
+	handleOrder := func(order *OrderMessage) error {
+		_, err := exchange.HandleOrder(context.Background(), order)
+		return err
+	}
+
+	if err := handleOrder(&OrderMessage{Id: 1, OrderSide: Side_BID, Price: 102, Quantity: 10}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a price off the tick to be rejected, got %v", err)
+	}
+	if err := handleOrder(&OrderMessage{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 15}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an odd lot to be rejected, got %v", err)
+	}
+	if err := handleOrder(&OrderMessage{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handleOrder(&OrderMessage{Command: Command_MODIFY, Id: 1, Price: 103, Quantity: 20}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a modify off the tick to be rejected, got %v", err)
+	}
+	if order, err := exchange.getOrder(0, 1); err != nil || order.GetPrice() != 100 {
+		t.Fatal("the rejected modify changed the resting order")
+	}
+
+	state := exchange.GetOrderBookState(0)
+	if state.PriceScale != 2 {
+		t.Fatalf("book state reports a price scale of %d, want 2", state.PriceScale)
+	}
+	if text := state.ObsToString(); !strings.Contains(text, "Price: 1.00, Quantity: 20") {
+		t.Errorf("expected the bid shown as 1.00, got\n%s", text)
+	}
+}
+
+// Market orders are valued at the far touch, a book that has never traded
+// still has its resting orders to go on
+func TestMarketOrderNotional(t *testing.T) {
+	exchange := NewExchange()
+	// At most 1000 notional
+	referenceData, err := ob.NewReferenceData(1, 1, 1, 0, 1000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exchange.AddOrderbookWithReferenceData(0, "SPY", referenceData); err != nil {
+		t.Fatal(err)
+	}
+	handleOrder := func(order *OrderMessage) error {
+		_, err := exchange.HandleOrder(context.Background(), order)
+		return err
+	}
+	marketBid := func(id uint64, quantity uint64) *OrderMessage {
+		return &OrderMessage{Id: id, OrderType: OrderType_MARKET, OrderSide: Side_BID, Quantity: quantity, OrderTimeInForce: OrderTimeInForce_IOC}
+	}
+
+	if err := handleOrder(marketBid(1, 5)); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a market order on an empty book to be rejected, got %v", err)
+	}
+	if err := handleOrder(&OrderMessage{Id: 2, OrderSide: Side_ASK, Price: 100, Quantity: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := handleOrder(marketBid(3, 11)); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected 11 at 100 to be over the maximum notional, got %v", err)
+	}
+	if err := handleOrder(marketBid(4, 4)); err != nil {
+		t.Fatal(err)
+	}
+	if order, err := exchange.getOrder(0, 2); err != nil || order.GetOpenQuantity() != 6 {
+		t.Fatal("expected the first trade to take 4 of the ask")
+	}
+}
@@ -0,0 +1,130 @@
+package exchange
+
+import ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+
+// Commands each session state accepts. Auctions take the same commands as
+// continuous trading, the book itself rejects orders that need to trade
+// straight away.
+var sessionCommands = map[ob.SessionState][]Command{
+	ob.Continuous:        {Command_ADD, Command_DELETE, Command_REPLACE, Command_CANCEL, Command_MODIFY},
+	ob.PreOpen:           {Command_DELETE, Command_CANCEL},
+	ob.OpeningAuction:    {Command_ADD, Command_DELETE, Command_REPLACE, Command_CANCEL, Command_MODIFY},
+	ob.Halted:            {Command_DELETE, Command_CANCEL},
+	ob.ClosingAuction:    {Command_ADD, Command_DELETE, Command_REPLACE, Command_CANCEL, Command_MODIFY},
+	ob.Closed:            {},
+	ob.VolatilityAuction: {Command_ADD, Command_DELETE, Command_REPLACE, Command_CANCEL, Command_MODIFY},
+}
+
+func commandAllowed(state ob.SessionState, command Command) bool {
+	for _, allowed := range sessionCommands[state] {
+		if allowed == command {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSessionState moves a symbol to another trading phase and publishes the
+// new state on the feed. Entering an auction stops the book matching, moving
+// to continuous trading or closing from the closing auction uncrosses it and
+// returns the auction trades. A halt keeps whatever auction is running.
+// Volatility auctions end by themselves, see EndVolatilityAuctions.
+func (exchange *Exchange) SetSessionState(symbolId uint64, state ob.SessionState) ([]ob.Trade, error) {
+	exchange.Mu.Lock()
+	trades, err := exchange.setSessionState(symbolId, state)
+	exchange.Mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	exchange.NotifyClients(symbolId)
+	return trades, nil
+}
+
+func (exchange *Exchange) GetSessionState(symbolId uint64) (ob.SessionState, error) {
+	exchange.Mu.RLock()
+	defer exchange.Mu.RUnlock()
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return ob.Continuous, err
+	}
+	return exchange.symbolMap[symbolId].GetSessionState(), nil
+}
+
+func (exchange *Exchange) setSessionState(symbolId uint64, state ob.SessionState) ([]ob.Trade, error) {
+	if err := exchange.checkOrderbookExists(symbolId); err != nil {
+		return nil, err
+	}
+	symbol := exchange.symbolMap[symbolId]
+	current := symbol.GetSessionState()
+	if !current.CanMoveTo(state) {
+		return nil, newSymbolError(symbolId, ErrInvalidSessionTransition)
+	}
+
+	var trades []ob.Trade
+	inAuction := exchange.orderBooks[symbolId].IsInAuction()
+	switch {
+	case state.IsAuction() && !inAuction:
+		if err := exchange.startAuction(symbolId); err != nil {
+			return nil, err
+		}
+	case inAuction && (state == ob.Continuous || current == ob.ClosingAuction && state == ob.Closed):
+		var err error
+		if trades, err = exchange.uncross(symbolId); err != nil {
+			return nil, err
+		}
+	}
+	delete(exchange.volatilityAuctionEnds, symbolId)
+	if state == ob.VolatilityAuction {
+		exchange.volatilityAuctionEnds[symbolId] = exchange.clock.Now().Add(exchange.volatilityAuctionPeriod)
+	}
+	symbol.SetSessionState(state)
+	exchange.publishers[symbolId].sessionStatus(state)
+	return trades, nil
+}
+
+// Must be called with the lock held and after the book has been checked
+func (exchange *Exchange) checkCommandAllowed(symbolId uint64, command Command) error {
+	if !commandAllowed(exchange.symbolMap[symbolId].GetSessionState(), command) {
+		return newSymbolError(symbolId, ErrCommandNotAllowed)
+	}
+	return nil
+}
+
+func protoToObEnumSessionState(state SessionState) (ob.SessionState, error) {
+	switch state {
+	case SessionState_SESSION_CONTINUOUS:
+		return ob.Continuous, nil
+	case SessionState_SESSION_PRE_OPEN:
+		return ob.PreOpen, nil
+	case SessionState_SESSION_OPENING_AUCTION:
+		return ob.OpeningAuction, nil
+	case SessionState_SESSION_HALTED:
+		return ob.Halted, nil
+	case SessionState_SESSION_CLOSING_AUCTION:
+		return ob.ClosingAuction, nil
+	case SessionState_SESSION_CLOSED:
+		return ob.Closed, nil
+	case SessionState_SESSION_VOLATILITY_AUCTION:
+		return ob.VolatilityAuction, nil
+	default:
+		return ob.Continuous, ErrInvalidSessionState
+	}
+}
+
+func obToProtoSessionState(state ob.SessionState) SessionState {
+	switch state {
+	case ob.PreOpen:
+		return SessionState_SESSION_PRE_OPEN
+	case ob.OpeningAuction:
+		return SessionState_SESSION_OPENING_AUCTION
+	case ob.Halted:
+		return SessionState_SESSION_HALTED
+	case ob.ClosingAuction:
+		return SessionState_SESSION_CLOSING_AUCTION
+	case ob.Closed:
+		return SessionState_SESSION_CLOSED
+	case ob.VolatilityAuction:
+		return SessionState_SESSION_VOLATILITY_AUCTION
+	default:
+		return SessionState_SESSION_CONTINUOUS
+	}
+}
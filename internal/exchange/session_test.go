@@ -0,0 +1,223 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ob "github.com/Heian0/LeGoTradingEngine/internal/orderbook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessionStates(t *testing.T) {
+	exchange := NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	admin := NewAdminServer(exchange)
+	setState := func(state SessionState) {
+		t.Helper()
+		if _, err := admin.SetSessionState(context.Background(), &SessionStateRequest{SymbolId: 0, State: state}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handleOrder := func(order *OrderMessage) error {
+		_, err := exchange.HandleOrder(context.Background(), order)
+		return err
+	}
+
+	if err := handleOrder(&OrderMessage{Id: 1, OrderSide: Side_BID, Price: 100, Quantity: 10}); err != nil {
+		t.Fatal(err)
+	}
+	setState(SessionState_SESSION_CLOSED)
+	if err := handleOrder(&OrderMessage{Command: Command_DELETE, Id: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a delete to be refused while closed, got %v", err)
+	}
+	if _, err := admin.SetSessionState(context.Background(), &SessionStateRequest{State: SessionState_SESSION_CONTINUOUS}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected closed to continuous to be refused, got %v", err)
+	}
+
+	// Pre-open only takes cancels, the opening auction takes orders without matching
+	setState(SessionState_SESSION_PRE_OPEN)
+	if err := handleOrder(&OrderMessage{Id: 2, OrderSide: Side_ASK, Price: 100, Quantity: 4}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected an add to be refused in pre-open, got %v", err)
+	}
+	if err := handleOrder(&OrderMessage{Command: Command_CANCEL, Id: 1, Quantity: 5}); err != nil {
+		t.Fatal(err)
+	}
+	setState(SessionState_SESSION_OPENING_AUCTION)
+	if err := handleOrder(&OrderMessage{Id: 2, OrderSide: Side_ASK, Price: 100, Quantity: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exchange.getOrder(0, 2); err != nil {
+		t.Fatal("the ask traded during the opening auction")
+	}
+
+	// Opening uncrosses the auction
+	trades, err := exchange.SetSessionState(0, ob.Continuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].GetQuantity() != 4 || trades[0].GetPrice() != 100 {
+		t.Fatalf("expected 4 uncrossed at 100, got %v", trades)
+	}
+
+	setState(SessionState_SESSION_HALTED)
+	if err := handleOrder(&OrderMessage{Id: 3, OrderSide: Side_ASK, Price: 100, Quantity: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected an add to be refused while halted, got %v", err)
+	}
+	if err := handleOrder(&OrderMessage{Command: Command_DELETE, Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if state := exchange.GetOrderBookState(0).SessionState; state != SessionState_SESSION_HALTED {
+		t.Errorf("book state reports %v, want halted", state)
+	}
+}
+
+// Session changes are sent before anything else the change caused
+func TestSessionStatusFeed(t *testing.T) {
+	exchange := NewExchange()
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exchange.SetSessionState(0, ob.ClosingAuction); err != nil {
+		t.Fatal(err)
+	}
+	messages := exchange.publishers[0].retransmit(1, 2)
+	if len(messages) != 2 || messages[0].GetSessionStatus().GetState() != SessionState_SESSION_CLOSING_AUCTION || messages[1].GetAuctionUpdate() == nil {
+		t.Fatalf("expected a closing auction status then the indicative uncross, got %v", messages)
+	}
+	snapshot := exchange.publishers[0].snapshot(exchange.orderBooks[0])
+	if state := snapshot.GetSnapshot().GetSessionState(); state != SessionState_SESSION_CLOSING_AUCTION {
+		t.Errorf("snapshot reports %v, want the closing auction", state)
+	}
+}
+
+func TestVolatilityAuction(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
+	exchange := NewExchange()
+	exchange.SetClock(clock)
+	exchange.SetVolatilityAuctionPeriod(time.Minute)
+	if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+		t.Fatal(err)
+	}
+	// 5% either side of 100
+	if err := exchange.SetPriceBands(0, ob.NewPriceBands(100, 0, 500)); err != nil {
+		t.Fatal(err)
+	}
+	for _, order := range []*OrderMessage{
+		{Id: 1, OrderSide: Side_ASK, Price: 100, Quantity: 5},
+		{Id: 2, OrderSide: Side_ASK, Price: 110, Quantity: 5},
+		{Id: 3, OrderType: OrderType_MARKET, OrderSide: Side_BID, Quantity: 10, OrderTimeInForce: OrderTimeInForce_IOC},
+	} {
+		if _, err := exchange.HandleOrder(context.Background(), order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if state, _ := exchange.GetSessionState(0); state != ob.VolatilityAuction {
+		t.Fatalf("session is %v after trading outside the collar, want a volatility auction", state)
+	}
+	if _, err := exchange.getOrder(0, 2); err != nil {
+		t.Fatal("the ask outside the collar traded")
+	}
+	if _, err := exchange.HandleOrder(context.Background(), &OrderMessage{Id: 4, OrderSide: Side_BID, Price: 108, Quantity: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	exchange.EndVolatilityAuctions()
+	if state, _ := exchange.GetSessionState(0); state != ob.VolatilityAuction {
+		t.Fatalf("session is %v before the auction period is over", state)
+	}
+	clock.now = clock.now.Add(time.Minute)
+	exchange.EndVolatilityAuctions()
+	if state, _ := exchange.GetSessionState(0); state != ob.Continuous {
+		t.Fatalf("session is %v after the auction period, want continuous", state)
+	}
+	// Nothing crosses, 108 bid against 110 asked
+	if order, err := exchange.getOrder(0, 4); err != nil || order.GetOpenQuantity() != 2 {
+		t.Error("the bid should still rest after the auction")
+	}
+}
+
+// Commands that bypass HandleOrder move the session as soon as the book hits
+// its collar, not when clients are next notified
+func TestVolatilityAuctionWithoutNotify(t *testing.T) {
+	order := func(newOrder ob.Order, err error) *ob.Order {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &newOrder
+	}
+	// Bid stops trigger straight away on a book that has not traded
+	addStopAbove99 := func(exchange *Exchange) error {
+		if err := exchange.ExecuteOrderWithSpecifiedPrice(0, 1, 1, 99); err != nil {
+			return err
+		}
+		_, err := exchange.AddOrder(order(ob.StopBidOrder(5, 0, 10, 100, ob.GoodTillCancel)))
+		return err
+	}
+	for name, command := range map[string]func(exchange *Exchange) error{
+		"add": func(exchange *Exchange) error {
+			_, err := exchange.AddOrder(order(ob.LimitBidOrder(3, 0, 10, 110, ob.GoodTillCancel)))
+			return err
+		},
+		"modify": func(exchange *Exchange) error {
+			_, _, err := exchange.ModifyOrder(0, 3, 110, 10)
+			return err
+		},
+		"replace": func(exchange *Exchange) error {
+			_, _, err := exchange.ReplaceOrder(0, 3, 4, 110)
+			return err
+		},
+		// The stop bid buys through the collar once the execution at 100 fires it
+		"execute": func(exchange *Exchange) error {
+			if err := addStopAbove99(exchange); err != nil {
+				return err
+			}
+			return exchange.ExecuteOrderWithSpecifiedPrice(0, 1, 4, 100)
+		},
+		"execute without price": func(exchange *Exchange) error {
+			if err := addStopAbove99(exchange); err != nil {
+				return err
+			}
+			return exchange.ExecuteOrderWithoutPrice(0, 1, 4)
+		},
+	} {
+		clock := &fakeClock{now: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
+		exchange := NewExchange()
+		exchange.SetClock(clock)
+		exchange.SetVolatilityAuctionPeriod(time.Minute)
+		if err := exchange.AddOrderbook(0, "SPY"); err != nil {
+			t.Fatal(err)
+		}
+		// 5% either side of 100
+		if err := exchange.SetPriceBands(0, ob.NewPriceBands(100, 0, 500)); err != nil {
+			t.Fatal(err)
+		}
+		for _, newOrder := range []*ob.Order{
+			order(ob.LimitAskOrder(1, 0, 5, 100, ob.GoodTillCancel)),
+			order(ob.LimitAskOrder(2, 0, 5, 110, ob.GoodTillCancel)),
+		} {
+			if _, err := exchange.AddOrder(newOrder); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if name != "add" {
+			if _, err := exchange.AddOrder(order(ob.LimitBidOrder(3, 0, 10, 90, ob.GoodTillCancel))); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := command(exchange); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if state, _ := exchange.GetSessionState(0); state != ob.VolatilityAuction {
+			t.Errorf("%s: session is %v after trading up to the collar, want a volatility auction", name, state)
+		}
+		if end, exists := exchange.volatilityAuctionEnds[0]; !exists || !end.Equal(clock.now.Add(time.Minute)) {
+			t.Errorf("%s: expected the auction to end after a minute, got %v", name, end)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package orderbook
+
+import "sort"
+
+// Where a call auction would uncross if it ended now. The imbalance is the
+// quantity left over at the price on the imbalance side.
+type IndicativeUncross struct {
+	price         uint64
+	volume        uint64
+	imbalance     uint64
+	imbalanceSide Side
+}
+
+func (uncross IndicativeUncross) GetPrice() uint64 {
+	return uncross.price
+}
+
+// Zero when the book does not cross, the price is then zero as well
+func (uncross IndicativeUncross) GetVolume() uint64 {
+	return uncross.volume
+}
+
+func (uncross IndicativeUncross) GetImbalance() uint64 {
+	return uncross.imbalance
+}
+
+func (uncross IndicativeUncross) GetImbalanceSide() Side {
+	return uncross.imbalanceSide
+}
+
+func (orderBook *OrderBook) IsInAuction() bool {
+	return orderBook.inAuction
+}
+
+// StartAuction stops continuous matching. Limit orders rest wherever they are
+// priced until Uncross, orders that need to trade straight away are rejected.
+func (orderBook *OrderBook) StartAuction() error {
+	if orderBook.inAuction {
+		return ErrAuctionInProgress
+	}
+	orderBook.inAuction = true
+	return nil
+}
+
+// Uncross executes everything that crosses at the single price found by
+// GetIndicativeUncross and returns the book to continuous matching. Auction
+// trades have no real aggressor, the side with the imbalance is reported as
+// the aggressor and self trade prevention does not apply. The uncross price
+// is not held to the price bands, it becomes the centre of the next collar.
+func (orderBook *OrderBook) Uncross() ([]Trade, error) {
+	if !orderBook.inAuction {
+		return nil, ErrNoAuction
+	}
+	uncross := orderBook.GetIndicativeUncross()
+	orderBook.inAuction = false
+	orderBook.volatilityAuction = false
+	orderBook.trades = nil
+	// Both sides are taken best first, at the maximum volume price nothing
+	// crosses once either side runs out at that price
+	for uncross.volume > 0 && !orderBook.bidLevels.IsEmpty() && !orderBook.askLevels.IsEmpty() {
+		bidLevel := orderBook.GetBestBid()
+		askLevel := orderBook.GetBestAsk()
+		if bidLevel.price < uncross.price || askLevel.price > uncross.price {
+			break
+		}
+		orderBook.executeAuctionOrders(askLevel.Front(), bidLevel.Front(), uncross)
+	}
+	orderBook.ActivateStopOrders()
+	orderBook.ValidateOrderbook()
+	trades := orderBook.trades
+	orderBook.trades = nil
+	return trades, nil
+}
+
+func (orderBook *OrderBook) executeAuctionOrders(askOrder *Order, bidOrder *Order, uncross IndicativeUncross) {
+	orderBook.ExecuteOrders(askOrder, bidOrder, uncross.price, uncross.imbalanceSide)
+	for _, order := range []*Order{askOrder, bidOrder} {
+		if order.IsFilled() {
+			orderBook.DeleteOrder(order.id, true)
+		} else {
+			orderBook.replenishOrder(order)
+		}
+	}
+}
+
+type auctionLevel struct {
+	price    uint64
+	quantity uint64
+}
+
+// GetIndicativeUncross finds the price that executes the most quantity, ties
+// go to the smallest imbalance. Every price between the tied prices does as
+// well, the one closest to the reference price is taken, or the midpoint of
+// the range when there is no reference. Hidden iceberg quantity takes part,
+// resting stops do not.
+func (orderBook *OrderBook) GetIndicativeUncross() IndicativeUncross {
+	bids := auctionLevels(orderBook.bidLevels)
+	asks := auctionLevels(orderBook.askLevels)
+	prices := make([]uint64, 0, len(bids)+len(asks))
+	for _, level := range append(append([]auctionLevel{}, bids...), asks...) {
+		prices = append(prices, level.price)
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i] < prices[j] })
+
+	var demand uint64 = 0
+	for _, level := range bids {
+		demand += level.quantity
+	}
+	var supply uint64 = 0
+	best := IndicativeUncross{}
+	// Highest price tied with best, prices are visited in ascending order
+	var tiedPrice uint64 = 0
+	bidIndex, askIndex := 0, 0
+	for _, price := range prices {
+		// Bids below the price drop out, asks at or below it join
+		for bidIndex < len(bids) && bids[bidIndex].price < price {
+			demand -= bids[bidIndex].quantity
+			bidIndex++
+		}
+		for askIndex < len(asks) && asks[askIndex].price <= price {
+			supply += asks[askIndex].quantity
+			askIndex++
+		}
+		candidate := newIndicativeUncross(price, demand, supply)
+		if candidate.volume == 0 {
+			continue
+		}
+		if candidate.volume > best.volume || candidate.volume == best.volume && candidate.imbalance < best.imbalance {
+			best, tiedPrice = candidate, price
+		} else if candidate.volume == best.volume && candidate.imbalance == best.imbalance {
+			tiedPrice = price
+		}
+	}
+	if best.volume == 0 || tiedPrice == best.price {
+		return best
+	}
+
+	var price uint64
+	if reference, exists := orderBook.referencePrice(); exists {
+		price = min(max(reference, best.price), tiedPrice)
+	} else {
+		price = best.price + (tiedPrice-best.price)/2
+	}
+	// The lowest tied price is a level price, so it is never rounded past
+	price -= price % orderBook.tickSize
+	demand, supply = 0, 0
+	for _, level := range bids {
+		if level.price >= price {
+			demand += level.quantity
+		}
+	}
+	for _, level := range asks {
+		if level.price <= price {
+			supply += level.quantity
+		}
+	}
+	return newIndicativeUncross(price, demand, supply)
+}
+
+func newIndicativeUncross(price uint64, demand uint64, supply uint64) IndicativeUncross {
+	uncross := IndicativeUncross{price: price, volume: min(demand, supply)}
+	if demand >= supply {
+		uncross.imbalance, uncross.imbalanceSide = demand-supply, Bid
+	} else {
+		uncross.imbalance, uncross.imbalanceSide = supply-demand, Ask
+	}
+	return uncross
+}
+
+// Levels in ascending price with their open quantity, hidden reserves included
+func auctionLevels(levels *LevelMap) []auctionLevel {
+	auctionLevels := []auctionLevel{}
+	levels.SetMapBegin()
+	levelsIt := levels.levelMapIterator
+	for levelsIt.Next() {
+		level := levelsIt.Value().(*Level)
+		auctionLevel := auctionLevel{price: level.price}
+		for e := level.orders.Front(); e != nil; e = e.Next() {
+			auctionLevel.quantity += e.Value.(*Order).openQuantity
+		}
+		auctionLevels = append(auctionLevels, auctionLevel)
+	}
+	return auctionLevels
+}
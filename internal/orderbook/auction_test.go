@@ -0,0 +1,140 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+// Book that last traded at lastPrice and is now in an auction
+func newAuctionOrderBook(t *testing.T, lastPrice uint64) *OrderBook {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	for _, newOrder := range []*Order{
+		order(LimitAskOrder(1, 0, 1, lastPrice, GoodTillCancel)),
+		order(LimitBidOrder(2, 0, 1, lastPrice, GoodTillCancel)),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := orderBook.StartAuction(); err != nil {
+		t.Fatal(err)
+	}
+	return orderBook
+}
+
+func TestAuctionUncross(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newAuctionOrderBook(t, 100)
+	for _, newOrder := range []*Order{
+		order(LimitBidOrder(10, 0, 10, 102, GoodTillCancel)),
+		order(LimitBidOrder(11, 0, 5, 101, GoodTillCancel)),
+		order(LimitAskOrder(12, 0, 8, 99, GoodTillCancel)),
+		order(LimitAskOrder(13, 0, 4, 101, GoodTillCancel)),
+	} {
+		trades, err := orderBook.AddOrder(newOrder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(trades) != 0 {
+			t.Fatalf("traded during the auction: %v", trades)
+		}
+	}
+	if _, err := orderBook.AddOrder(order(MarketBidOrder(14, 0, 1, ImmediateOrCancel))); !errors.Is(err, ErrNotAllowedInAuction) {
+		t.Fatalf("expected ErrNotAllowedInAuction, got %v", err)
+	}
+
+	// 12 can trade at 101, more than at 99 or 102
+	uncross := orderBook.GetIndicativeUncross()
+	if uncross.GetPrice() != 101 || uncross.GetVolume() != 12 || uncross.GetImbalance() != 3 || uncross.GetImbalanceSide() != Bid {
+		t.Fatalf("indicative %d at %d with %d %v imbalance, want 12 at 101 with 3 Bid imbalance",
+			uncross.GetVolume(), uncross.GetPrice(), uncross.GetImbalance(), uncross.GetImbalanceSide())
+	}
+
+	trades, err := orderBook.Uncross()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var volume uint64 = 0
+	for i := range trades {
+		if trades[i].GetPrice() != 101 {
+			t.Errorf("trade at %d, want 101", trades[i].GetPrice())
+		}
+		volume += trades[i].GetQuantity()
+	}
+	if volume != 12 {
+		t.Errorf("uncrossed %d, want 12", volume)
+	}
+	if orderBook.IsInAuction() {
+		t.Error("still in the auction after uncrossing")
+	}
+	if bid := orderBook.GetBestBid(); bid.GetPrice() != 101 || bid.GetVolume() != 3 || !orderBook.askLevels.IsEmpty() {
+		t.Errorf("expected 3 bid at 101 and no asks, got %v", orderBook)
+	}
+	if _, err := orderBook.Uncross(); !errors.Is(err, ErrNoAuction) {
+		t.Errorf("expected ErrNoAuction, got %v", err)
+	}
+}
+
+// Equal volume and imbalance anywhere from 95 to 105, the last price decides
+func TestAuctionReferencePriceTieBreak(t *testing.T) {
+	order := mustOrder(t)
+	for lastPrice, want := range map[uint64]uint64{100: 100, 104: 104, 110: 105, 90: 95} {
+		orderBook := newAuctionOrderBook(t, lastPrice)
+		for _, newOrder := range []*Order{
+			order(LimitBidOrder(10, 0, 5, 105, GoodTillCancel)),
+			order(LimitAskOrder(11, 0, 5, 95, GoodTillCancel)),
+		} {
+			if _, err := orderBook.AddOrder(newOrder); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if price := orderBook.GetIndicativeUncross().GetPrice(); price != want {
+			t.Errorf("last price %d: uncross at %d, want %d", lastPrice, price, want)
+		}
+	}
+}
+
+// A book that has never traded falls back on its price bands' reference, or
+// the middle of the tied prices without one
+func TestOpeningAuctionTieBreak(t *testing.T) {
+	order := mustOrder(t)
+	for _, test := range []struct {
+		referencePrice uint64
+		tickSize       uint64
+		want           uint64
+	}{
+		{referencePrice: 0, tickSize: 1, want: 102},
+		// Rounded down onto the tick
+		{referencePrice: 0, tickSize: 5, want: 100},
+		{referencePrice: 98, tickSize: 1, want: 98},
+		{referencePrice: 120, tickSize: 5, want: 110},
+	} {
+		orderBook := NewOrderbook(0)
+		orderBook.SetTickSize(test.tickSize)
+		orderBook.SetPriceBands(NewPriceBands(test.referencePrice, 0, 0))
+		if err := orderBook.StartAuction(); err != nil {
+			t.Fatal(err)
+		}
+		for _, newOrder := range []*Order{
+			order(LimitBidOrder(1, 0, 5, 110, GoodTillCancel)),
+			order(LimitAskOrder(2, 0, 5, 95, GoodTillCancel)),
+		} {
+			if _, err := orderBook.AddOrder(newOrder); err != nil {
+				t.Fatal(err)
+			}
+		}
+		uncross := orderBook.GetIndicativeUncross()
+		if uncross.GetPrice() != test.want || uncross.GetVolume() != 5 || uncross.GetImbalance() != 0 {
+			t.Errorf("reference %d and tick %d: %d uncross at %d with %d imbalance, want 5 at %d",
+				test.referencePrice, test.tickSize, uncross.GetVolume(), uncross.GetPrice(), uncross.GetImbalance(), test.want)
+		}
+		trades, err := orderBook.Uncross()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(trades) != 1 || trades[0].GetPrice() != test.want {
+			t.Errorf("reference %d and tick %d: uncrossed as %v", test.referencePrice, test.tickSize, trades)
+		}
+	}
+}
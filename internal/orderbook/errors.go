@@ -0,0 +1,55 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownOrder               = errors.New("unknown order")
+	ErrDuplicateOrderId           = errors.New("duplicate order id")
+	ErrInvalidTimeInForce         = errors.New("invalid time in force for order type")
+	ErrInvalidExpireTime          = errors.New("invalid expire time for time in force")
+	ErrInvalidQuantity            = errors.New("invalid order quantity")
+	ErrInvalidExecution           = errors.New("execution quantity exceeds open quantity")
+	ErrOrderNotInLevel            = errors.New("order not found in level")
+	ErrOrderNotResting            = errors.New("order is not resting in the book")
+	ErrInvalidDisplayQuantity     = errors.New("invalid display quantity")
+	ErrInvalidPostOnly            = errors.New("post only is only valid for limit orders that can rest")
+	ErrPostOnlyWouldCross         = errors.New("post only order would cross the spread")
+	ErrInvalidSelfTradePrevention = errors.New("invalid self trade prevention mode")
+	ErrInvalidPeg                 = errors.New("pegs are only valid for limit orders that can rest")
+	ErrNoPegPrice                 = errors.New("no price for the pegged order to follow")
+	ErrInvalidOrderGroup          = errors.New("invalid order group")
+	ErrAuctionInProgress          = errors.New("auction already in progress")
+	ErrNoAuction                  = errors.New("no auction in progress")
+	ErrNotAllowedInAuction        = errors.New("order must be able to rest during an auction")
+	ErrDuplicateGroupId           = errors.New("duplicate order group id")
+	ErrPriceOutsideBand           = errors.New("price outside the static price band")
+	ErrInvalidReferenceData       = errors.New("invalid symbol reference data")
+	ErrPriceNotOnTick             = errors.New("price is not a multiple of the tick size")
+	ErrQuantityNotInLots          = errors.New("quantity is not a multiple of the lot size")
+	ErrQuantityBelowMinimum       = errors.New("quantity below the minimum order quantity")
+	ErrQuantityAboveMaximum       = errors.New("quantity above the maximum order quantity")
+	ErrNotionalAboveMaximum       = errors.New("notional above the maximum order notional")
+	ErrNoMarketPrice              = errors.New("no market price to check the order's notional at")
+)
+
+// OrderError ties one of the sentinel errors above to the order that caused it,
+// use errors.Is to check which kind of failure occurred
+type OrderError struct {
+	OrderId uint64
+	Err     error
+}
+
+func (err *OrderError) Error() string {
+	return fmt.Sprintf("order %d: %v", err.OrderId, err.Err)
+}
+
+func (err *OrderError) Unwrap() error {
+	return err.Err
+}
+
+func newOrderError(orderId uint64, err error) error {
+	return &OrderError{OrderId: orderId, Err: err}
+}
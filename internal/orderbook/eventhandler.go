@@ -0,0 +1,126 @@
+package orderbook
+
+// EventHandler is notified by an OrderBook every time an order changes state.
+// Callbacks are made synchronously while the book is being modified, so the
+// order and level pointers are only valid for the duration of the call and
+// must not be modified or retained.
+type EventHandler interface {
+	// Order has been inserted into one of the book's levels
+	OnOrderAdded(order *Order, level *Level)
+	// Order has been modified in place, e.g. a stop order was activated or a
+	// trailing stop was re-priced
+	OnOrderUpdated(order *Order, level *Level)
+	// Part of an order was cancelled, quantity is the amount removed. Level is
+	// nil when an order that cannot rest has its remainder cancelled.
+	OnOrderCancelled(order *Order, level *Level, quantity uint64)
+	// Order traded quantity at price, level is nil for an aggressing order
+	// which is not resting in the book
+	OnOrderExecuted(order *Order, level *Level, price uint64, quantity uint64)
+	// Order has left the book, either fully filled, deleted or never rested
+	OnOrderDeleted(order *Order, level *Level)
+	// Order has left the book to be entered again as its amended copy
+	// newOrder, which is added straight after. The book treats it as a
+	// deletion of order.
+	OnOrderReplaced(order *Order, level *Level, newOrder *Order)
+	// Two orders matched, called after OnOrderExecuted for both sides
+	OnTrade(trade *Trade)
+}
+
+// NullEventHandler ignores every event, it is the default for a new OrderBook
+type NullEventHandler struct{}
+
+func (NullEventHandler) OnOrderAdded(order *Order, level *Level) {}
+
+func (NullEventHandler) OnOrderUpdated(order *Order, level *Level) {}
+
+func (NullEventHandler) OnOrderCancelled(order *Order, level *Level, quantity uint64) {}
+
+func (NullEventHandler) OnOrderExecuted(order *Order, level *Level, price uint64, quantity uint64) {}
+
+func (NullEventHandler) OnOrderDeleted(order *Order, level *Level) {}
+
+func (NullEventHandler) OnOrderReplaced(order *Order, level *Level, newOrder *Order) {}
+
+func (NullEventHandler) OnTrade(trade *Trade) {}
+
+// EventHandlers passes every event on to each handler in turn
+type EventHandlers []EventHandler
+
+func (handlers EventHandlers) OnOrderAdded(order *Order, level *Level) {
+	for _, handler := range handlers {
+		handler.OnOrderAdded(order, level)
+	}
+}
+
+func (handlers EventHandlers) OnOrderUpdated(order *Order, level *Level) {
+	for _, handler := range handlers {
+		handler.OnOrderUpdated(order, level)
+	}
+}
+
+func (handlers EventHandlers) OnOrderCancelled(order *Order, level *Level, quantity uint64) {
+	for _, handler := range handlers {
+		handler.OnOrderCancelled(order, level, quantity)
+	}
+}
+
+func (handlers EventHandlers) OnOrderExecuted(order *Order, level *Level, price uint64, quantity uint64) {
+	for _, handler := range handlers {
+		handler.OnOrderExecuted(order, level, price, quantity)
+	}
+}
+
+func (handlers EventHandlers) OnOrderDeleted(order *Order, level *Level) {
+	for _, handler := range handlers {
+		handler.OnOrderDeleted(order, level)
+	}
+}
+
+func (handlers EventHandlers) OnOrderReplaced(order *Order, level *Level, newOrder *Order) {
+	for _, handler := range handlers {
+		handler.OnOrderReplaced(order, level, newOrder)
+	}
+}
+
+func (handlers EventHandlers) OnTrade(trade *Trade) {
+	for _, handler := range handlers {
+		handler.OnTrade(trade)
+	}
+}
+
+// Every order event advances the book's sequence number before it is passed on
+
+func (orderBook *OrderBook) onOrderAdded(order *Order, level *Level) {
+	orderBook.trackGroupOrder(order)
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderAdded(order, level)
+}
+
+func (orderBook *OrderBook) onOrderUpdated(order *Order, level *Level) {
+	orderBook.trackGroupOrder(order)
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderUpdated(order, level)
+}
+
+func (orderBook *OrderBook) onOrderCancelled(order *Order, level *Level, quantity uint64) {
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderCancelled(order, level, quantity)
+}
+
+func (orderBook *OrderBook) onOrderExecuted(order *Order, level *Level, price uint64, quantity uint64) {
+	orderBook.recordGroupEvent(order, true)
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderExecuted(order, level, price, quantity)
+}
+
+func (orderBook *OrderBook) onOrderDeleted(order *Order, level *Level) {
+	orderBook.recordGroupEvent(order, false)
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderDeleted(order, level)
+}
+
+// Requeued orders are not deletions as far as their group is concerned
+func (orderBook *OrderBook) onOrderReplaced(order *Order, level *Level, newOrder *Order) {
+	orderBook.sequenceNumber++
+	orderBook.eventHandler.OnOrderReplaced(order, level, newOrder)
+}
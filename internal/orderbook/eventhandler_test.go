@@ -0,0 +1,95 @@
+package orderbook
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// Writes every event down in the order the book sends them
+type eventLog struct {
+	events []string
+}
+
+func (log *eventLog) OnOrderAdded(order *Order, level *Level) {
+	log.events = append(log.events, fmt.Sprintf("added %d at %d", order.id, level.price))
+}
+
+func (log *eventLog) OnOrderUpdated(order *Order, level *Level) {
+	log.events = append(log.events, fmt.Sprintf("updated %d", order.id))
+}
+
+func (log *eventLog) OnOrderCancelled(order *Order, level *Level, quantity uint64) {
+	log.events = append(log.events, fmt.Sprintf("cancelled %d by %d", order.id, quantity))
+}
+
+func (log *eventLog) OnOrderExecuted(order *Order, level *Level, price uint64, quantity uint64) {
+	log.events = append(log.events, fmt.Sprintf("executed %d for %d at %d", order.id, quantity, price))
+}
+
+func (log *eventLog) OnOrderDeleted(order *Order, level *Level) {
+	log.events = append(log.events, fmt.Sprintf("deleted %d", order.id))
+}
+
+func (log *eventLog) OnOrderReplaced(order *Order, level *Level, newOrder *Order) {
+	log.events = append(log.events, fmt.Sprintf("replaced %d with %d", order.id, newOrder.id))
+}
+
+func (log *eventLog) OnTrade(trade *Trade) {
+	log.events = append(log.events, fmt.Sprintf("trade %d", trade.tradeId))
+}
+
+// Takes the events logged so far
+func (log *eventLog) take() []string {
+	events := log.events
+	log.events = nil
+	return events
+}
+
+func expectEvents(t *testing.T, log *eventLog, want ...string) {
+	t.Helper()
+	if got := log.take(); !slices.Equal(got, want) {
+		t.Fatalf("expected events\n%q\ngot\n%q", want, got)
+	}
+}
+
+func TestEventHandler(t *testing.T) {
+	order := mustOrder(t)
+	log := &eventLog{}
+	orderBook := NewOrderbookWithEventHandler(0, log)
+	addOrder := func(newOrder *Order) {
+		t.Helper()
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	addOrder(order(LimitAskOrder(1, 0, 5, 100, GoodTillCancel)))
+	expectEvents(t, log, "added 1 at 100")
+	// Aggressors that fill never rest but still leave the book
+	addOrder(order(LimitBidOrder(2, 0, 1, 100, GoodTillCancel)))
+	expectEvents(t, log, "executed 1 for 1 at 100", "executed 2 for 1 at 100", "trade 1", "deleted 2")
+	addOrder(order(StopBidOrder(3, 0, 2, 101, GoodTillCancel)))
+	expectEvents(t, log, "added 3 at 101")
+
+	if err := orderBook.CancelOrder(1, 1); err != nil {
+		t.Fatal(err)
+	}
+	expectEvents(t, log, "cancelled 1 by 1")
+
+	// The sweep to 101 triggers the stop, which finds nothing left to buy
+	addOrder(order(LimitAskOrder(4, 0, 5, 101, GoodTillCancel)))
+	addOrder(order(LimitBidOrder(5, 0, 8, 101, GoodTillCancel)))
+	expectEvents(t, log,
+		"added 4 at 101",
+		"executed 1 for 3 at 100", "executed 5 for 3 at 100", "trade 2", "deleted 1",
+		"executed 4 for 5 at 101", "executed 5 for 5 at 101", "trade 3", "deleted 4", "deleted 5",
+		"updated 3", "cancelled 3 by 2", "deleted 3",
+	)
+
+	addOrder(order(LimitAskOrder(6, 0, 5, 102, GoodTillCancel)))
+	if err := orderBook.DelOrder(6); err != nil {
+		t.Fatal(err)
+	}
+	expectEvents(t, log, "added 6 at 102", "deleted 6")
+}
@@ -0,0 +1,54 @@
+package orderbook
+
+import "testing"
+
+// Unwraps the order returned by one of the order constructors
+func mustOrder(t *testing.T) func(Order, error) *Order {
+	return func(order Order, err error) *Order {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &order
+	}
+}
+
+// Records the cancel and delete events of the order under test
+type recordingEventHandler struct {
+	NullEventHandler
+	orderId   uint64
+	cancelled uint64
+	deleted   bool
+}
+
+func (handler *recordingEventHandler) OnOrderCancelled(order *Order, level *Level, quantity uint64) {
+	if order.GetId() == handler.orderId {
+		handler.cancelled += quantity
+	}
+}
+
+func (handler *recordingEventHandler) OnOrderDeleted(order *Order, level *Level) {
+	if order.GetId() == handler.orderId {
+		handler.deleted = true
+	}
+}
+
+// Book with a trade at 100, 5 asked at 101 and 5 bid at 99
+func newMatrixOrderBook(t *testing.T, handler EventHandler) *OrderBook {
+	orderBook := NewOrderbookWithEventHandler(0, handler)
+	orders := []func() (Order, error){
+		func() (Order, error) { return LimitAskOrder(1, 0, 1, 100, GoodTillCancel) },
+		func() (Order, error) { return LimitBidOrder(2, 0, 1, 100, GoodTillCancel) },
+		func() (Order, error) { return LimitAskOrder(3, 0, 5, 101, GoodTillCancel) },
+		func() (Order, error) { return LimitBidOrder(4, 0, 5, 99, GoodTillCancel) },
+	}
+	for _, newOrder := range orders {
+		order, err := newOrder()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := orderBook.AddOrder(&order); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return orderBook
+}
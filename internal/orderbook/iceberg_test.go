@@ -0,0 +1,98 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIcebergOrders(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	addOrder := func(newOrder *Order) []Trade {
+		t.Helper()
+		trades, err := orderBook.AddOrder(newOrder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return trades
+	}
+	expectAsks := func(step string, volume uint64, want ...uint64) {
+		t.Helper()
+		level := orderBook.GetBestAsk()
+		if level.GetVolume() != volume {
+			t.Errorf("%s: expected a volume of %d shown, got %d", step, volume, level.GetVolume())
+		}
+		if !level.ValidateLevel() {
+			t.Fatalf("%s: the level volume does not match its orders", step)
+		}
+		views := orderBook.GetTopNAskOrders(1)
+		if len(views)*2 != len(want) {
+			t.Fatalf("%s: expected %d orders queued, got %v", step, len(want)/2, views)
+		}
+		for i := range views {
+			if views[i].GetOrderId() != want[2*i] || views[i].GetQuantity() != want[2*i+1] {
+				t.Errorf("%s: expected order %d showing %d at position %d, got %v", step, want[2*i], want[2*i+1], i, views[i])
+			}
+		}
+	}
+	expectTrades := func(step string, trades []Trade, want ...uint64) {
+		t.Helper()
+		if len(trades)*2 != len(want) {
+			t.Fatalf("%s: expected %d trades, got %v", step, len(want)/2, trades)
+		}
+		for i := range trades {
+			if trades[i].GetAskOrderId() != want[2*i] || trades[i].GetQuantity() != want[2*i+1] {
+				t.Errorf("%s: expected trade %d of %d with order %d, got %v", step, i, want[2*i+1], want[2*i], trades[i])
+			}
+		}
+	}
+
+	iceberg := order(LimitAskOrder(1, 0, 100, 100, GoodTillCancel))
+	iceberg.SetDisplayQuantity(10)
+	addOrder(iceberg)
+	addOrder(order(LimitAskOrder(2, 0, 5, 100, GoodTillCancel)))
+	expectAsks("added", 15, 1, 10, 2, 5)
+
+	// The slice fills and the next one joins the back of the queue
+	trades := addOrder(order(LimitBidOrder(3, 0, 12, 100, GoodTillCancel)))
+	expectTrades("slice filled", trades, 1, 10, 2, 2)
+	expectAsks("slice filled", 13, 2, 3, 1, 10)
+	if iceberg.GetOpenQuantity() != 90 || iceberg.GetHiddenQuantity() != 80 {
+		t.Errorf("expected 90 open with 80 hidden, got %d and %d", iceberg.GetOpenQuantity(), iceberg.GetHiddenQuantity())
+	}
+
+	// A sweep keeps taking slices as they are shown
+	trades = addOrder(order(LimitBidOrder(4, 0, 25, 100, GoodTillCancel)))
+	expectTrades("sweep", trades, 2, 3, 1, 10, 1, 10, 1, 2)
+	expectAsks("sweep", 8, 1, 8)
+
+	// Cancels come out of the hidden reserve first
+	if err := orderBook.CancelOrder(1, 60); err != nil {
+		t.Fatal(err)
+	}
+	expectAsks("reserve cancelled", 8, 1, 8)
+	if err := orderBook.CancelOrder(1, 5); err != nil {
+		t.Fatal(err)
+	}
+	expectAsks("slice cancelled", 3, 1, 3)
+
+	// Fill or kill counts the hidden reserve as available
+	hidden := order(LimitAskOrder(5, 0, 50, 101, GoodTillCancel))
+	hidden.SetDisplayQuantity(5)
+	addOrder(hidden)
+	fillOrKill := order(LimitBidOrder(6, 0, 40, 101, FillOrKill))
+	addOrder(fillOrKill)
+	if !fillOrKill.IsFilled() || hidden.GetOpenQuantity() != 13 {
+		t.Errorf("expected the fill or kill to fill against the reserve, %d of order 5 left", hidden.GetOpenQuantity())
+	}
+
+	for name, newOrder := range map[string]*Order{
+		"market":             order(MarketBidOrder(7, 0, 5, ImmediateOrCancel)),
+		"display above size": order(LimitBidOrder(8, 0, 5, 99, GoodTillCancel)),
+	} {
+		newOrder.SetDisplayQuantity(6)
+		if _, err := orderBook.AddOrder(newOrder); !errors.Is(err, ErrInvalidDisplayQuantity) {
+			t.Errorf("%s: expected the display quantity to be refused, got %v", name, err)
+		}
+	}
+}
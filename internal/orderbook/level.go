@@ -6,6 +6,8 @@ import (
 	"strconv"
 )
 
+// Volume only counts the visible quantity of iceberg orders, their hidden
+// reserve is not part of the level
 type Level struct {
 	levelSide Side
 	price     uint64
@@ -33,7 +35,11 @@ func (level Level) ValidateLevel() bool {
 			fmt.Println("Level should never contain market orders")
 			return false
 		}
-		actualVolume += order.openQuantity
+		if order.IsIceberg() && (order.visibleQuantity > order.displayQuantity || order.visibleQuantity > order.openQuantity) {
+			fmt.Println("Iceberg order shows more than its display quantity")
+			return false
+		}
+		actualVolume += order.GetVisibleQuantity()
 	}
 	if actualVolume != level.volume {
 		fmt.Println("Incorrect level volume")
@@ -85,7 +91,7 @@ func (level *Level) AddOrder(order *Order) {
 		return
 	}
 	level.orders.PushBack(order)
-	level.volume += order.openQuantity
+	level.volume += order.GetVisibleQuantity()
 	if !level.ValidateLevel() {
 		panic("Invalid add order operation")
 	}
@@ -97,7 +103,7 @@ func (level *Level) PopFront() {
 	}
 	orderElem := level.orders.Front()
 	orderToRemove := orderElem.Value.(*Order)
-	level.volume -= orderToRemove.openQuantity
+	level.volume -= orderToRemove.GetVisibleQuantity()
 	level.orders.Remove(orderElem)
 	if !level.ValidateLevel() {
 		panic("Invalid add order operation")
@@ -110,32 +116,29 @@ func (level *Level) PopBack() {
 	}
 	orderElem := level.orders.Back()
 	orderToRemove := orderElem.Value.(*Order)
-	level.volume -= orderToRemove.openQuantity
+	level.volume -= orderToRemove.GetVisibleQuantity()
 	level.orders.Remove(orderElem)
 	if !level.ValidateLevel() {
 		panic("Invalid add order operation")
 	}
 }
 
-func (level *Level) DeleteOrder(orderToRemove *Order) {
-	if level.orders.Len() == 0 {
-		panic("No orders to delete!")
-	}
+func (level *Level) DeleteOrder(orderToRemove *Order) error {
 
 	for ord := level.orders.Front(); ord != nil; ord = ord.Next() {
 		order := ord.Value.(*Order)
 		if order.Equals(orderToRemove) {
-			level.volume -= order.openQuantity
+			level.volume -= order.GetVisibleQuantity()
 			level.orders.Remove(ord)
 
 			if !level.ValidateLevel() {
 				panic("Invalid level state after deleting order")
 			}
-			return
+			return nil
 		}
 	}
 
-	panic("Order not found in the list!")
+	return newOrderError(orderToRemove.id, ErrOrderNotInLevel)
 }
 
 func (level *Level) ReduceVolume(amountToReduce uint64) {
@@ -148,6 +151,40 @@ func (level *Level) ReduceVolume(amountToReduce uint64) {
 	}
 }
 
+// Moves an iceberg order to the back of the level once its visible quantity
+// has been refreshed, the new slice is added to the volume
+func (level *Level) ReplenishOrder(order *Order) error {
+	for ord := level.orders.Front(); ord != nil; ord = ord.Next() {
+		if ord.Value.(*Order).Equals(order) {
+			level.orders.MoveToBack(ord)
+			level.volume += order.GetVisibleQuantity()
+			if !level.ValidateLevel() {
+				panic("Invalid level state after replenishing order")
+			}
+			return nil
+		}
+	}
+	return newOrderError(order.id, ErrOrderNotInLevel)
+}
+
+// Open quantity the aggressor could trade against in the level, hidden
+// reserves included. Counting stops at the first order self trade prevention
+// would cancel the aggressor on, the bool is then false.
+func (level *Level) availableQuantity(aggressor *Order) (uint64, bool) {
+	var quantity uint64 = 0
+	for ord := level.orders.Front(); ord != nil; ord = ord.Next() {
+		order := ord.Value.(*Order)
+		if aggressor.IsSelfTrade(order) {
+			if aggressor.selfTradePrevention != CancelResting {
+				return quantity, false
+			}
+			continue
+		}
+		quantity += order.openQuantity
+	}
+	return quantity, true
+}
+
 func (level *Level) Front() *Order {
 	if level.orders.Len() == 0 {
 		panic("Level is empty")
@@ -0,0 +1,52 @@
+package orderbook
+
+// MassCancelScope picks the resting orders a mass cancel takes out. The zero
+// scope, also returned by AllOrders, picks every order, each With call narrows
+// it further.
+type MassCancelScope struct {
+	symbolId      uint64
+	bySymbol      bool
+	participantId uint64
+	byParticipant bool
+	side          Side
+	bySide        bool
+}
+
+func AllOrders() MassCancelScope {
+	return MassCancelScope{}
+}
+
+func (scope MassCancelScope) WithSymbol(symbolId uint64) MassCancelScope {
+	scope.symbolId, scope.bySymbol = symbolId, true
+	return scope
+}
+
+func (scope MassCancelScope) WithParticipant(participantId uint64) MassCancelScope {
+	scope.participantId, scope.byParticipant = participantId, true
+	return scope
+}
+
+func (scope MassCancelScope) WithSide(side Side) MassCancelScope {
+	scope.side, scope.bySide = side, true
+	return scope
+}
+
+// Returns the symbol the scope is limited to, if it is
+func (scope MassCancelScope) GetSymbolId() (uint64, bool) {
+	return scope.symbolId, scope.bySymbol
+}
+
+func (scope MassCancelScope) Includes(order *Order) bool {
+	return (!scope.bySymbol || order.symbolId == scope.symbolId) &&
+		(!scope.byParticipant || order.participantId == scope.participantId) &&
+		(!scope.bySide || order.orderSide == scope.side)
+}
+
+// MassCancel deletes every resting order in scope, stops included, sending a
+// deletion event for each. Returns the cancelled orders in id order.
+func (orderBook *OrderBook) MassCancel(scope MassCancelScope) []*Order {
+	if symbolId, bySymbol := scope.GetSymbolId(); bySymbol && symbolId != orderBook.symbolId {
+		return []*Order{}
+	}
+	return orderBook.deleteOrders(scope.Includes)
+}
@@ -0,0 +1,47 @@
+package orderbook
+
+import (
+	"testing"
+)
+
+func TestMassCancel(t *testing.T) {
+	order := mustOrder(t)
+	handler := &recordingEventHandler{orderId: 12}
+	// Trade at 100, 5 asked at 101 and 5 bid at 99 without a participant
+	orderBook := newMatrixOrderBook(t, handler)
+	for _, newOrder := range []*Order{
+		order(LimitAskOrder(10, 0, 5, 102, GoodTillCancel)),
+		order(LimitBidOrder(11, 0, 5, 98, GoodTillCancel)),
+		order(StopAskOrder(12, 0, 5, 90, GoodTillCancel)),
+	} {
+		newOrder.SetParticipantId(7)
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cancelledIds := func(cancelled []*Order) []uint64 {
+		ids := []uint64{}
+		for _, order := range cancelled {
+			ids = append(ids, order.GetId())
+		}
+		return ids
+	}
+
+	if cancelled := orderBook.MassCancel(AllOrders().WithSymbol(1)); len(cancelled) != 0 {
+		t.Fatalf("another symbol's scope cancelled %v", cancelledIds(cancelled))
+	}
+	cancelled := cancelledIds(orderBook.MassCancel(AllOrders().WithParticipant(7).WithSide(Ask)))
+	if len(cancelled) != 2 || cancelled[0] != 10 || cancelled[1] != 12 {
+		t.Fatalf("expected participant 7's asks 10 and 12 cancelled, got %v", cancelled)
+	}
+	if !handler.deleted {
+		t.Error("no deletion event for the cancelled stop")
+	}
+	cancelled = cancelledIds(orderBook.MassCancel(AllOrders().WithSymbol(0).WithSide(Bid)))
+	if len(cancelled) != 2 || cancelled[0] != 4 || cancelled[1] != 11 {
+		t.Fatalf("expected bids 4 and 11 cancelled, got %v", cancelled)
+	}
+	if _, resting := orderBook.GetOrder(3); !resting {
+		t.Error("the ask outside the scope was cancelled")
+	}
+}
@@ -0,0 +1,75 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestModifyOrderPriority(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	for id := uint64(1); id <= 3; id++ {
+		if _, err := orderBook.AddOrder(order(LimitAskOrder(id, 0, 10, 100, GoodTillCancel))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectQueue := func(step string, want ...uint64) {
+		t.Helper()
+		views := orderBook.GetTopNAskOrders(10)
+		if len(views)*3 != len(want) {
+			t.Fatalf("%s: expected %d orders queued, got %v", step, len(want)/3, views)
+		}
+		for i := range views {
+			if views[i].GetOrderId() != want[3*i] || views[i].GetPrice() != want[3*i+1] || views[i].GetQuantity() != want[3*i+2] {
+				t.Errorf("%s: expected order %d showing %d at %d in position %d, got %v", step, want[3*i], want[3*i+2], want[3*i+1], i, views[i])
+			}
+		}
+	}
+	modifyOrder := func(orderId uint64, newPrice uint64, newQuantity uint64) []Trade {
+		t.Helper()
+		_, trades, err := orderBook.ModifyOrder(orderId, newPrice, newQuantity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return trades
+	}
+
+	modifyOrder(1, 100, 4)
+	expectQueue("size-down", 1, 100, 4, 2, 100, 10, 3, 100, 10)
+	modifyOrder(1, 100, 4)
+	expectQueue("unchanged", 1, 100, 4, 2, 100, 10, 3, 100, 10)
+	modifyOrder(1, 100, 6)
+	expectQueue("size-up", 2, 100, 10, 3, 100, 10, 1, 100, 6)
+	modifyOrder(2, 101, 10)
+	expectQueue("worse price", 3, 100, 10, 1, 100, 6, 2, 101, 10)
+	modifyOrder(2, 100, 10)
+	expectQueue("back to the old price", 3, 100, 10, 1, 100, 6, 2, 100, 10)
+
+	// Executed and cancelled quantity still count towards the total
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(4, 0, 3, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	modifyOrder(3, 100, 2)
+	if resting, _ := orderBook.GetOrder(3); resting.GetExecutedQuantity() != 3 || resting.GetQuantity() != 10 {
+		t.Errorf("expected 3 executed out of 10, got %d out of %d", resting.GetExecutedQuantity(), resting.GetQuantity())
+	}
+	expectQueue("after a fill", 3, 100, 2, 1, 100, 6, 2, 100, 10)
+
+	// Moving through the spread trades like a new order
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(5, 0, 5, 99, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	trades := modifyOrder(5, 100, 5)
+	if len(trades) != 2 || trades[0].GetAskOrderId() != 3 || trades[0].GetQuantity() != 2 || trades[1].GetAskOrderId() != 1 || trades[1].GetQuantity() != 3 {
+		t.Fatalf("expected the bid to take 2 from order 3 and 3 from order 1, got %v", trades)
+	}
+	expectQueue("crossing modify", 1, 100, 3, 2, 100, 10)
+
+	if _, _, err := orderBook.ModifyOrder(9, 100, 1); !errors.Is(err, ErrUnknownOrder) {
+		t.Errorf("expected an unknown order to be refused, got %v", err)
+	}
+	if _, _, err := orderBook.ModifyOrder(1, 100, 0); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("expected a zero quantity to be invalid, got %v", err)
+	}
+	expectQueue("rejected modifies", 1, 100, 3, 2, 100, 10)
+}
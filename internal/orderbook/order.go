@@ -42,6 +42,10 @@ const (
 	GoodTillCancel    = 0
 	ImmediateOrCancel = 1
 	FillOrKill        = 2
+	// Day and good till date orders rest until their expire time, the book
+	// only removes them when asked to expire orders
+	Day          = 3
+	GoodTillDate = 4
 )
 
 func (orderTimeInForce OrderTimeInForce) String() string {
@@ -52,11 +56,98 @@ func (orderTimeInForce OrderTimeInForce) String() string {
 		return "Immediate Or Cancel"
 	case FillOrKill:
 		return "Fill Or Kill"
+	case Day:
+		return "Day"
+	case GoodTillDate:
+		return "Good Till Date"
 	default:
 		return "Unknown Order In Force"
 	}
 }
 
+// Post only orders never take liquidity, when one would cross the spread it is
+// either rejected or repriced one tick behind the best opposite price
+type PostOnly int
+
+const (
+	NotPostOnly     = 0
+	PostOnlyReject  = 1
+	PostOnlyReprice = 2
+)
+
+func (postOnly PostOnly) String() string {
+	switch postOnly {
+	case NotPostOnly:
+		return "Not Post Only"
+	case PostOnlyReject:
+		return "Post Only Reject"
+	case PostOnlyReprice:
+		return "Post Only Reprice"
+	default:
+		return "Unknown Post Only"
+	}
+}
+
+// Decides what happens when an aggressor meets a resting order from the same
+// participant, the aggressor's mode is the one applied
+type SelfTradePrevention int
+
+const (
+	NoSelfTradePrevention = 0
+	CancelResting         = 1
+	CancelAggressor       = 2
+	CancelBoth            = 3
+	// Both orders are reduced by the smaller open quantity, whichever reaches
+	// zero is cancelled
+	DecrementAndCancel = 4
+)
+
+func (selfTradePrevention SelfTradePrevention) String() string {
+	switch selfTradePrevention {
+	case NoSelfTradePrevention:
+		return "None"
+	case CancelResting:
+		return "Cancel Resting"
+	case CancelAggressor:
+		return "Cancel Aggressor"
+	case CancelBoth:
+		return "Cancel Both"
+	case DecrementAndCancel:
+		return "Decrement And Cancel"
+	default:
+		return "Unknown Self Trade Prevention"
+	}
+}
+
+// Pegged orders are limit orders whose price follows the book, the offset in
+// ticks is added to the price they peg to
+type PegType int
+
+const (
+	NotPegged = 0
+	// Follows the best price on the order's own side
+	PrimaryPeg = 1
+	// Follows the best price on the opposite side
+	MarketPeg = 2
+	// Follows the midpoint of the best bid and ask, which may be half a tick
+	MidpointPeg = 3
+)
+
+func (pegType PegType) String() string {
+	switch pegType {
+	case NotPegged:
+		return "Not Pegged"
+	case PrimaryPeg:
+		return "Primary Peg"
+	case MarketPeg:
+		return "Market Peg"
+	case MidpointPeg:
+		return "Midpoint Peg"
+	default:
+		return "Unknown Peg Type"
+	}
+}
+
 type Side int
 
 const (
@@ -87,18 +178,34 @@ type Order struct {
 	lastExecutedPrice    uint64
 	quantity             uint64
 	executedQuantity     uint64
+	executedValue        uint64
 	openQuantity         uint64
 	lastExecutedQuantity uint64
-	levelPtr             *Level
+	// Iceberg orders only show displayQuantity at a time, zero shows everything.
+	// visibleQuantity is what is left of the current slice while resting.
+	displayQuantity uint64
+	visibleQuantity uint64
+	postOnly        PostOnly
+	// Unix nanoseconds, only set for day and good till date orders
+	expireTime int64
+	levelPtr   *Level
+	// Session that entered the order, the book only carries it around
+	clientId string
+	// Firm or account the order belongs to, zero if unknown
+	participantId       uint64
+	selfTradePrevention SelfTradePrevention
+	pegType             PegType
+	pegOffset           int64
 }
 
 // OrderToString returns a formatted string with Order details
 func (order Order) String() string {
-	return fmt.Sprintf("Order ID: %d\nType: %v\nSide: %v\nTime in Force: %v\nSymbol ID: %d\nPrice: %d\nStop Price: %d\nTrailing Amount: %d\nQuantity: %d\nExecuted Quantity: %d\nOpen Quantity: %d\nLast Executed Price: %d\nLast Executed Quantity: %d",
+	return fmt.Sprintf("Order ID: %d\nType: %v\nSide: %v\nTime in Force: %v\nExpire Time: %d\nSymbol ID: %d\nPrice: %d\nStop Price: %d\nTrailing Amount: %d\nQuantity: %d\nExecuted Quantity: %d\nOpen Quantity: %d\nDisplay Quantity: %d\nPost Only: %v\nPeg: %v\nPeg Offset: %d\nParticipant ID: %d\nSelf Trade Prevention: %v\nLast Executed Price: %d\nLast Executed Quantity: %d",
 		order.id,
 		order.orderType,
 		order.orderSide,
 		order.orderTimeInForce,
+		order.expireTime,
 		order.symbolId,
 		order.price,
 		order.stopPrice,
@@ -106,6 +213,12 @@ func (order Order) String() string {
 		order.quantity,
 		order.executedQuantity,
 		order.openQuantity,
+		order.displayQuantity,
+		order.postOnly,
+		order.pegType,
+		order.pegOffset,
+		order.participantId,
+		order.selfTradePrevention,
 		order.lastExecutedPrice,
 		order.lastExecutedQuantity,
 	)
@@ -113,135 +226,184 @@ func (order Order) String() string {
 
 // ---------------- Order Input Verification
 
-func (order Order) ValidateOrder() bool {
+func (order Order) ValidateOrder() error {
+	if order.quantity == 0 {
+		return newOrderError(order.id, ErrInvalidQuantity)
+	}
+	switch order.orderTimeInForce {
+	case GoodTillCancel, ImmediateOrCancel, FillOrKill:
+		if order.expireTime != 0 {
+			return newOrderError(order.id, ErrInvalidExpireTime)
+		}
+	case Day, GoodTillDate:
+		// The expire time is set after construction, AddOrder checks it is there
+	default:
+		return newOrderError(order.id, ErrInvalidTimeInForce)
+	}
+	// Only orders that rest at a limit price can hide part of their quantity
+	if order.displayQuantity > order.quantity {
+		return newOrderError(order.id, ErrInvalidDisplayQuantity)
+	}
+	if order.displayQuantity > 0 && !order.IsLimit() && !order.IsStopLimit() && !order.IsTrailingStopLimit() {
+		return newOrderError(order.id, ErrInvalidDisplayQuantity)
+	}
+	switch order.postOnly {
+	case NotPostOnly:
+	case PostOnlyReject, PostOnlyReprice:
+		if !order.IsLimit() && !order.IsStopLimit() && !order.IsTrailingStopLimit() {
+			return newOrderError(order.id, ErrInvalidPostOnly)
+		}
+		if order.IsImmediateOrCancel() || order.IsFillOrKill() {
+			return newOrderError(order.id, ErrInvalidPostOnly)
+		}
+	default:
+		return newOrderError(order.id, ErrInvalidPostOnly)
+	}
+	switch order.selfTradePrevention {
+	case NoSelfTradePrevention, CancelResting, CancelAggressor, CancelBoth, DecrementAndCancel:
+	default:
+		return newOrderError(order.id, ErrInvalidSelfTradePrevention)
+	}
+	// Pegs only make sense on limit orders that rest, the book sets their price
+	switch order.pegType {
+	case NotPegged:
+	case PrimaryPeg, MarketPeg, MidpointPeg:
+		if !order.IsLimit() || order.IsImmediateOrCancel() || order.IsFillOrKill() || order.IsPostOnly() {
+			return newOrderError(order.id, ErrInvalidPeg)
+		}
+	default:
+		return newOrderError(order.id, ErrInvalidPeg)
+	}
 	switch order.orderType {
 	case Market:
-		if order.orderTimeInForce == GoodTillCancel {
-			fmt.Printf("Invalid Market order, please review the following order (id: %v, symbolId: %v, quantity: %v, orderTimeInForce: %v)\n", order.id, order.symbolId, order.quantity, order.orderTimeInForce)
-			return false
+		if !order.IsImmediateOrCancel() && !order.IsFillOrKill() {
+			return newOrderError(order.id, ErrInvalidTimeInForce)
 		}
 	case Stop:
 		if order.orderTimeInForce == FillOrKill {
-			fmt.Printf("Invalid Stop order, please review the following order (id: %v, symbolId: %v, quantity: %v, stopPrice: %v, orderTimeInForce: %v)\n", order.id, order.symbolId, order.quantity, order.stopPrice, order.orderTimeInForce)
-			return false
+			return newOrderError(order.id, ErrInvalidTimeInForce)
 		}
 	case TrailingStop:
 		if order.orderTimeInForce == FillOrKill {
-			fmt.Printf("Invalid Trailing Stop order, please review the following order (id: %v, symbolId: %v, quantity: %v, trailingAmount: %v, orderTimeInForce: %v)\n", order.id, order.symbolId, order.quantity, order.trailingAmount, order.orderTimeInForce)
-			return false
+			return newOrderError(order.id, ErrInvalidTimeInForce)
 		}
 	}
 
 	// Valid Order
-	return true
+	return nil
 }
 
 // -----------------------------------------------
 
-func MarketBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _orderTimeInForce OrderTimeInForce) Order {
+func MarketBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Market, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func MarketAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _orderTimeInForce OrderTimeInForce) Order {
+func MarketAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Market, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func LimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _orderTimeInForce OrderTimeInForce) Order {
+func LimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Limit, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func LimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _orderTimeInForce OrderTimeInForce) Order {
+func LimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Limit, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func StopBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) Order {
+func StopBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Stop, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, stopPrice: _stopPrice}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func StopAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) Order {
+func StopAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: Stop, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, stopPrice: _stopPrice}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func StopLimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) Order {
+func StopLimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: StopLimit, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, stopPrice: _stopPrice}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func StopLimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) Order {
+func StopLimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _stopPrice uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: StopLimit, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, stopPrice: _stopPrice}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func TrailingStopBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) Order {
+func TrailingStopBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: TrailingStop, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, trailingAmount: _trailingAmount}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func TrailingStopAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) Order {
+func TrailingStopAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
 	order := Order{orderType: TrailingStop, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, trailingAmount: _trailingAmount}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func TrailingStopLimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) Order {
-	order := Order{orderType: TrailingStop, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, trailingAmount: _trailingAmount}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+func TrailingStopLimitBidOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
+	order := Order{orderType: TrailingStopLimit, orderSide: Bid, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, trailingAmount: _trailingAmount}
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func TrailingStopLimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) Order {
-	order := Order{orderType: TrailingStop, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, trailingAmount: _trailingAmount}
-	if !order.ValidateOrder() {
-		panic("Error, invalid order")
+func TrailingStopLimitAskOrder(_id uint64, _symbolId uint64, _quantity uint64, _price uint64, _trailingAmount uint64, _orderTimeInForce OrderTimeInForce) (Order, error) {
+	order := Order{orderType: TrailingStopLimit, orderSide: Ask, orderTimeInForce: _orderTimeInForce, id: _id, symbolId: _symbolId, quantity: _quantity, openQuantity: _quantity, price: _price, trailingAmount: _trailingAmount}
+	if err := order.ValidateOrder(); err != nil {
+		return Order{}, err
 	}
-	return order
+	return order, nil
 }
 
-func (order *Order) ExecuteOrder(_quantity uint64, _price uint64) {
+func (order *Order) ExecuteOrder(_quantity uint64, _price uint64) error {
 	if order.openQuantity < _quantity {
-		panic("Error, invalid order execution")
+		return newOrderError(order.id, ErrInvalidExecution)
 	}
 	order.openQuantity -= _quantity
+	// Executions use up the visible slice before the hidden reserve
+	order.visibleQuantity -= simplemath.Min(_quantity, order.visibleQuantity)
+	order.visibleQuantity = simplemath.Min(order.visibleQuantity, order.openQuantity)
 	order.executedQuantity += _quantity
+	order.executedValue += _quantity * _price
 	order.lastExecutedPrice = _price
 	order.lastExecutedQuantity = _quantity
+	return nil
 }
 
 func (order *Order) IsAsk() bool { return order.orderSide == Ask }
@@ -266,8 +428,28 @@ func (order *Order) IsImmediateOrCancel() bool { return order.orderTimeInForce =
 
 func (order *Order) IsFillOrKill() bool { return order.orderTimeInForce == FillOrKill }
 
+func (order *Order) IsDay() bool { return order.orderTimeInForce == Day }
+
+func (order *Order) IsGoodTillDate() bool { return order.orderTimeInForce == GoodTillDate }
+
+// Day and good till date orders are expired once now reaches their expire time
+func (order *Order) IsExpired(now int64) bool {
+	return (order.IsDay() || order.IsGoodTillDate()) && order.expireTime <= now
+}
+
 func (order *Order) IsFilled() bool { return order.openQuantity == 0 }
 
+func (order *Order) IsIceberg() bool { return order.displayQuantity > 0 }
+
+func (order *Order) IsPostOnly() bool { return order.postOnly != NotPostOnly }
+
+func (order *Order) IsPegged() bool { return order.pegType != NotPegged }
+
+// True if the order's self trade prevention applies against the other order
+func (order *Order) IsSelfTrade(otherOrder *Order) bool {
+	return order.selfTradePrevention != NoSelfTradePrevention && order.participantId != 0 && order.participantId == otherOrder.participantId
+}
+
 func (order *Order) Equals(otherOrder *Order) bool { return order.id == otherOrder.id }
 
 func (o *Order) GetOrderType() OrderType {
@@ -314,10 +496,59 @@ func (o *Order) GetExecutedQuantity() uint64 {
 	return o.executedQuantity
 }
 
+// Volume weighted price of everything the order has executed so far
+func (o *Order) GetAverageExecutedPrice() float64 {
+	if o.executedQuantity == 0 {
+		return 0
+	}
+	return float64(o.executedValue) / float64(o.executedQuantity)
+}
+
 func (o *Order) GetOpenQuantity() uint64 {
 	return o.openQuantity
 }
 
+func (o *Order) GetDisplayQuantity() uint64 {
+	return o.displayQuantity
+}
+
+// Quantity shown in the book, for icebergs this is the current slice
+func (o *Order) GetVisibleQuantity() uint64 {
+	if !o.IsIceberg() {
+		return o.openQuantity
+	}
+	return o.visibleQuantity
+}
+
+// Hidden reserve of an iceberg order
+func (o *Order) GetHiddenQuantity() uint64 {
+	return o.openQuantity - o.GetVisibleQuantity()
+}
+
+func (o *Order) GetExpireTime() int64 {
+	return o.expireTime
+}
+
+func (o *Order) GetPostOnly() PostOnly {
+	return o.postOnly
+}
+
+func (o *Order) GetPegType() PegType {
+	return o.pegType
+}
+
+func (o *Order) GetPegOffset() int64 {
+	return o.pegOffset
+}
+
+func (o *Order) GetParticipantId() uint64 {
+	return o.participantId
+}
+
+func (o *Order) GetSelfTradePrevention() SelfTradePrevention {
+	return o.selfTradePrevention
+}
+
 func (o *Order) GetLastExecutedQuantity() uint64 {
 	return o.lastExecutedQuantity
 }
@@ -326,8 +557,72 @@ func (o *Order) GetLevelPtr() *Level {
 	return o.levelPtr
 }
 
+func (o *Order) GetClientId() string {
+	return o.clientId
+}
+
+func (o *Order) SetClientId(clientId string) {
+	o.clientId = clientId
+}
+
+// Required for day and good till date orders, unix nanoseconds
+func (o *Order) SetExpireTime(expireTime int64) {
+	o.expireTime = expireTime
+}
+
+func (o *Order) SetParticipantId(participantId uint64) {
+	o.participantId = participantId
+}
+
+// Only used when the order is the aggressor and has a participant
+func (o *Order) SetSelfTradePrevention(selfTradePrevention SelfTradePrevention) {
+	o.selfTradePrevention = selfTradePrevention
+}
+
+// Checked when the order is added to a book, only limit orders that can rest
+// may be post only
+func (o *Order) SetPostOnly(postOnly PostOnly) {
+	o.postOnly = postOnly
+}
+
+// Pegs a limit order, its price is worked out by the book when the order is
+// added and whenever the prices it follows move
+func (o *Order) SetPeg(pegType PegType, pegOffset int64) {
+	o.pegType = pegType
+	o.pegOffset = pegOffset
+}
+
+// Turns the order into an iceberg showing displayQuantity at a time, checked
+// when the order is added to a book
+func (o *Order) SetDisplayQuantity(displayQuantity uint64) {
+	o.displayQuantity = displayQuantity
+}
+
 func (o *Order) ReduceQuantity(quantity uint64) {
 	q := simplemath.Min(quantity, o.openQuantity)
 	o.openQuantity -= q
-	o.ValidateOrder()
+	// Cancels come out of the hidden reserve first
+	o.visibleQuantity = simplemath.Min(o.visibleQuantity, o.openQuantity)
+}
+
+// Replacing or modifying an order changes the stop price of stop orders and
+// the limit price of everything else
+func (o *Order) amendablePrice() uint64 {
+	if o.IsStop() || o.IsStopLimit() || o.IsTrailingStop() {
+		return o.stopPrice
+	}
+	return o.price
+}
+
+func (o *Order) setAmendablePrice(price uint64) {
+	if o.IsStop() || o.IsStopLimit() || o.IsTrailingStop() {
+		o.stopPrice = price
+	} else {
+		o.price = price
+	}
+}
+
+// Shows the next slice of an iceberg, or all of the open quantity otherwise
+func (o *Order) refreshVisibleQuantity() {
+	o.visibleQuantity = simplemath.Min(o.displayQuantity, o.openQuantity)
 }
@@ -4,8 +4,10 @@ import (
 	"container/list"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	rbt "github.com/Heian0/LeGoTradingEngine/internal/utils/redblacktree"
 	simplemath "github.com/Heian0/LeGoTradingEngine/internal/utils/simplemath"
@@ -14,6 +16,8 @@ import (
 type OrderBook struct {
 	symbolId              uint64
 	lastExecutedPrice     uint64
+	sequenceNumber        uint64
+	lastTradeId           uint64
 	trailingBidPrice      uint64
 	trailingAskPrice      uint64
 	orders                map[uint64]*Order
@@ -23,20 +27,83 @@ type OrderBook struct {
 	stopBidLevels         *LevelMap
 	trailingStopAskLevels *LevelMap
 	trailingStopBidLevels *LevelMap
+	eventHandler          EventHandler
+	// Smallest price increment, in price units
+	tickSize uint64
+	// Prices pegged orders were last priced from
+	pegReferences pegReferences
+	// Orders only rest while a call auction is running
+	inAuction bool
+	// Set when the running auction was started by the dynamic collar
+	volatilityAuction bool
+	priceBands        PriceBands
+	// Trades generated by the current AddOrder/ReplaceOrder call
+	trades []Trade
+	groups map[uint64]*OrderGroup
+	// Group of every order that belongs to one, keyed by order id
+	orderGroups map[uint64]*OrderGroup
+	// Groups that can no longer change, oldest first, kept so their orders'
+	// status can still be queried
+	finishedGroups []*OrderGroup
+	// Executions and deletions of grouped orders waiting to be handled
+	orderGroupEvents []orderGroupEvent
 }
 
 func NewOrderbook(_symbolId uint64) *OrderBook {
+	return NewOrderbookWithEventHandler(_symbolId, NullEventHandler{})
+}
+
+func NewOrderbookWithEventHandler(_symbolId uint64, _eventHandler EventHandler) *OrderBook {
 	return &OrderBook{
 		symbolId:              _symbolId,
 		trailingAskPrice:      math.MaxUint64,
 		orders:                make(map[uint64]*Order),
+		groups:                make(map[uint64]*OrderGroup),
+		orderGroups:           make(map[uint64]*OrderGroup),
 		askLevels:             NewLevelMap(),
 		bidLevels:             NewLevelMap(),
 		stopAskLevels:         NewLevelMap(),
 		stopBidLevels:         NewLevelMap(),
 		trailingStopAskLevels: NewLevelMap(),
 		trailingStopBidLevels: NewLevelMap(),
+		eventHandler:          _eventHandler,
+		tickSize:              1,
+	}
+}
+
+func (orderBook *OrderBook) SetEventHandler(eventHandler EventHandler) {
+	if eventHandler == nil {
+		eventHandler = NullEventHandler{}
+	}
+	orderBook.eventHandler = eventHandler
+}
+
+func (orderBook *OrderBook) GetSymbolId() uint64 {
+	return orderBook.symbolId
+}
+
+func (orderBook *OrderBook) GetTickSize() uint64 {
+	return orderBook.tickSize
+}
+
+// Sets the price increment used by post only repricing and peg offsets, an
+// even tick size lets midpoint pegs rest at half ticks
+func (orderBook *OrderBook) SetTickSize(tickSize uint64) {
+	if tickSize == 0 {
+		tickSize = 1
 	}
+	orderBook.tickSize = tickSize
+}
+
+// Sequence number of the last event emitted by the book
+func (orderBook *OrderBook) GetSequenceNumber() uint64 {
+	return orderBook.sequenceNumber
+}
+
+// Returns the order if it is still resting in the book, including untriggered stops
+func (orderBook *OrderBook) GetOrder(orderId uint64) (*Order, bool) {
+	order, exists := orderBook.orders[orderId]
+	return order, exists
 }
 
 func (orderBook *OrderBook) LastExecutedPriceBid() uint64 {
@@ -51,7 +118,62 @@ func (orderBook *OrderBook) LastExecutedPriceAsk() uint64 {
 	}
 }
 
-func (orderBook *OrderBook) AddOrder(order *Order) {
+// Returns every trade generated by the order, including trades from any stop
+// orders it activated
+func (orderBook *OrderBook) AddOrder(order *Order) ([]Trade, error) {
+	if err := orderBook.checkNewOrder(order); err != nil {
+		return nil, err
+	}
+	orderBook.trades = nil
+	orderBook.addOrder(order)
+	orderBook.ActivateStopOrders()
+	orderBook.ValidateOrderbook()
+	trades := orderBook.trades
+	orderBook.trades = nil
+	return trades, nil
+}
+
+func (orderBook *OrderBook) checkNewOrder(order *Order) error {
+	return orderBook.checkOrder(order, nil)
+}
+
+// Checks an order can enter the book. An amended copy is checked while
+// requeued, the order it replaces, still rests so that a rejected amend leaves
+// it untouched.
+func (orderBook *OrderBook) checkOrder(order *Order, requeued *Order) error {
+	if err := order.ValidateOrder(); err != nil {
+		return err
+	}
+	if (order.IsDay() || order.IsGoodTillDate()) && order.expireTime <= 0 {
+		return newOrderError(order.id, ErrInvalidExpireTime)
+	}
+	if err := orderBook.checkStaticBand(order); err != nil {
+		return err
+	}
+	if resting, exists := orderBook.orders[order.id]; exists && resting != requeued {
+		return newOrderError(order.id, ErrDuplicateOrderId)
+	}
+	if order.IsPegged() {
+		if _, priced := orderBook.pegPrice(order, orderBook.currentPegReferences()); !priced {
+			return newOrderError(order.id, ErrNoPegPrice)
+		}
+	}
+	if orderBook.inAuction && (order.IsMarket() || order.IsImmediateOrCancel() || order.IsFillOrKill() || order.IsPostOnly()) {
+		return newOrderError(order.id, ErrNotAllowedInAuction)
+	}
+	// Children waiting on their parent hold on to their ids
+	if group, exists := orderBook.liveGroupOf(order.id); exists && group.IsPending(order.id) {
+		return newOrderError(order.id, ErrDuplicateOrderId)
+	}
+	// Post only stop limits are only checked once they trigger
+	if order.IsLimit() && order.postOnly == PostOnlyReject && orderBook.Crosses(order) {
+		return newOrderError(order.id, ErrPostOnlyWouldCross)
+	}
+	return nil
+}
+
+// Enters a checked order without settling stops, callers activate them once done
+func (orderBook *OrderBook) addOrder(order *Order) {
 	switch order.orderType {
 	case Market:
 		orderBook.AddMarketOrder(order)
@@ -60,27 +182,49 @@ func (orderBook *OrderBook) AddOrder(order *Order) {
 	case Stop, StopLimit, TrailingStop, TrailingStopLimit:
 		orderBook.AddStopOrder(order)
 	}
-	orderBook.ActivateStopOrders()
-	orderBook.ValidateOrderbook()
 }
 
+// Market orders sweep up to the edge of the static band, or the whole book
+// when there is none
 func (orderBook *OrderBook) AddMarketOrder(order *Order) {
+	low, high := orderBook.staticBandLimits()
 	if order.IsAsk() {
-		order.price = 0
+		order.price = low
 	} else {
-		order.price = math.MaxUint64
+		order.price = high
 	}
 	orderBook.Match(order)
+	// Market orders never rest in the book
+	orderBook.cancelRemainder(order)
 }
 
 func (orderBook *OrderBook) AddLimitOrder(order *Order) {
+	if order.IsPegged() && !orderBook.setPegPrice(order) {
+		// Pegs entering later, such as group children, can find nothing to follow
+		orderBook.cancelRemainder(order)
+		return
+	}
+	if order.IsPostOnly() && orderBook.Crosses(order) && !orderBook.repricePostOnly(order) {
+		// Triggered post only stop limits can no longer be rejected, they are cancelled instead
+		orderBook.cancelRemainder(order)
+		return
+	}
 	orderBook.Match(order)
-	if !order.IsFilled() && !order.IsFillOrKill() {
+	if !order.IsFilled() && !order.IsImmediateOrCancel() && !order.IsFillOrKill() {
 		orderBook.InsertLimitOrder(order)
 	} else {
-		// eventHandler.handleorderdeleted...
-		return
+		orderBook.cancelRemainder(order)
+	}
+}
+
+// Cancels whatever an order that cannot rest has left after matching, then
+// reports that it is done with
+func (orderBook *OrderBook) cancelRemainder(order *Order) {
+	if remainder := order.GetOpenQuantity(); remainder > 0 {
+		order.ReduceQuantity(remainder)
+		orderBook.onOrderCancelled(order, nil, remainder)
 	}
+	orderBook.onOrderDeleted(order, nil)
 }
 
 func (orderBook *OrderBook) AddStopOrder(order *Order) {
@@ -103,7 +247,7 @@ func (orderBook *OrderBook) AddStopOrder(order *Order) {
 		}
 		order.stopPrice = 0
 		order.trailingAmount = 0
-		// Handle order updated
+		orderBook.onOrderUpdated(order, nil)
 		if order.IsMarket() {
 			orderBook.AddMarketOrder(order)
 		} else {
@@ -128,7 +272,9 @@ func (orderBook *OrderBook) InsertLimitOrder(order *Order) {
 	}
 	order.levelPtr = lvlPtr
 	orderBook.orders[order.id] = order
+	order.refreshVisibleQuantity()
 	lvlPtr.AddOrder(order)
+	orderBook.onOrderAdded(order, lvlPtr)
 }
 
 func (orderBook *OrderBook) InsertStopOrder(order *Order) {
@@ -141,18 +287,20 @@ func (orderBook *OrderBook) InsertStopOrder(order *Order) {
 	order.levelPtr = lvlPtr
 	orderBook.orders[order.id] = order
 	lvlPtr.AddOrder(order)
+	orderBook.onOrderAdded(order, lvlPtr)
 }
 
 func (orderBook *OrderBook) InsertTrailingStopOrder(order *Order) {
 	var lvlPtr *Level
 	if order.IsAsk() {
-		lvlPtr = orderBook.trailingStopAskLevels.Emplace(order.price, Ask, order.symbolId)
+		lvlPtr = orderBook.trailingStopAskLevels.Emplace(order.stopPrice, Ask, order.symbolId)
 	} else {
-		lvlPtr = orderBook.trailingStopAskLevels.Emplace(order.price, Bid, order.symbolId)
+		lvlPtr = orderBook.trailingStopBidLevels.Emplace(order.stopPrice, Bid, order.symbolId)
 	}
 	order.levelPtr = lvlPtr
 	orderBook.orders[order.id] = order
 	lvlPtr.AddOrder(order)
+	orderBook.onOrderAdded(order, lvlPtr)
 }
 
 func (orderBook *OrderBook) CalculateStopPrice(order *Order) uint64 {
@@ -181,10 +329,17 @@ func (orderBook *OrderBook) CalculateStopPrice(order *Order) uint64 {
 func (orderBook *OrderBook) ActivateStopOrders() {
 	activated := true
 	for activated {
-		activated = orderBook.ActivateBidStopOrders()
-		orderBook.UpdateAskStopOrders()
-		activated = orderBook.ActivateAskStopOrders() || activated
-		orderBook.UpdateBidStopOrders()
+		activated = false
+		// Stops triggered before a volatility auction wait for the uncross
+		if !orderBook.inAuction {
+			activated = orderBook.ActivateBidStopOrders()
+			orderBook.UpdateAskStopOrders()
+			activated = orderBook.ActivateAskStopOrders() || activated
+			orderBook.UpdateBidStopOrders()
+		}
+		// Group actions and pegs following the book can trade and trigger more stops
+		activated = orderBook.processOrderGroups() || activated
+		activated = orderBook.UpdatePeggedOrders() || activated
 	}
 }
 
@@ -197,19 +352,19 @@ func (orderBook *OrderBook) ActivateBidStopOrders() bool {
 
 	for stopLevelsIt.Next() && stopLevelsIt.Key().(uint64) <= orderBook.LastExecutedPriceAsk() {
 		activated_orders = true
-		var currStopOrder *Order = stopLevelsIt.Value().(*Order)
+		var currStopOrder *Order = stopLevelsIt.Value().(*Level).Front()
 		orderBook.ActivateStopOrder(*currStopOrder)
 		orderBook.stopBidLevels.SetMapBegin()
 		stopLevelsIt = orderBook.stopBidLevels.levelMapIterator
 	}
 
-	// orderBook.stopBidLevels.Iterator is now at the beginning
+	// orderBook.trailingStopBidLevels.Iterator is now at the beginning
 	orderBook.trailingStopBidLevels.SetMapBegin()
 	trailingStopLevelsIt := orderBook.trailingStopBidLevels.levelMapIterator
 
 	for trailingStopLevelsIt.Next() && trailingStopLevelsIt.Key().(uint64) <= orderBook.LastExecutedPriceAsk() {
 		activated_orders = true
-		var currTrailingStopOrder *Order = trailingStopLevelsIt.Value().(*Order)
+		var currTrailingStopOrder *Order = trailingStopLevelsIt.Value().(*Level).Front()
 		orderBook.ActivateStopOrder(*currTrailingStopOrder)
 		orderBook.trailingStopBidLevels.SetMapBegin()
 		trailingStopLevelsIt = orderBook.trailingStopBidLevels.levelMapIterator
@@ -226,19 +381,19 @@ func (orderBook *OrderBook) ActivateAskStopOrders() bool {
 
 	for stopLevelsIt.Prev() && stopLevelsIt.Key().(uint64) >= orderBook.LastExecutedPriceBid() {
 		activated_orders = true
-		var currStopOrder *Order = stopLevelsIt.Value().(*Order)
+		var currStopOrder *Order = stopLevelsIt.Value().(*Level).Front()
 		orderBook.ActivateStopOrder(*currStopOrder)
 		orderBook.stopAskLevels.SetMapEnd()
 		stopLevelsIt = orderBook.stopAskLevels.levelMapIterator
 	}
 
-	// orderBook.stopAskLevels.Iterator is now at the end
-	orderBook.trailingStopBidLevels.SetMapEnd()
+	// orderBook.trailingStopAskLevels.Iterator is now at the end
+	orderBook.trailingStopAskLevels.SetMapEnd()
 	trailingStopLevelsIt := orderBook.trailingStopAskLevels.levelMapIterator
 
 	for trailingStopLevelsIt.Prev() && trailingStopLevelsIt.Key().(uint64) >= orderBook.LastExecutedPriceBid() {
 		activated_orders = true
-		var currTrailingStopOrder *Order = trailingStopLevelsIt.Value().(*Order)
+		var currTrailingStopOrder *Order = trailingStopLevelsIt.Value().(*Level).Front()
 		orderBook.ActivateStopOrder(*currTrailingStopOrder)
 		orderBook.trailingStopAskLevels.SetMapEnd()
 		trailingStopLevelsIt = orderBook.trailingStopAskLevels.levelMapIterator
@@ -251,14 +406,15 @@ func (orderBook *OrderBook) ActivateStopOrder(order Order) {
 	orderBook.DeleteOrder(order.id, false)
 	order.stopPrice = 0
 	order.trailingAmount = 0
+	order.levelPtr = nil
 	// Convert to limit/market
 	if order.IsStop() || order.IsTrailingStop() {
 		order.orderType = Market
-		//Handle order updated
+		orderBook.onOrderUpdated(&order, nil)
 		orderBook.AddMarketOrder(&order)
 	} else {
 		order.orderType = Limit
-		//Handle order updated
+		orderBook.onOrderUpdated(&order, nil)
 		orderBook.AddLimitOrder(&order)
 	}
 }
@@ -273,12 +429,15 @@ func (orderBook *OrderBook) UpdateBidStopOrders() {
 	trailingStopLevelsIt := orderBook.trailingStopBidLevels.levelMapIterator
 
 	for trailingStopLevelsIt.Next() {
-		for !trailingStopLevelsIt.Value().(*Level).Empty() {
-			var order *Order = trailingStopLevelsIt.Value().(*Level).Front()
+		level := trailingStopLevelsIt.Value().(*Level)
+		for !level.Empty() {
+			var order *Order = level.Front()
+			level.PopFront()
 			newStopPrice := orderBook.CalculateStopPrice(order)
-			newPtr := newTrailingStopBidLevels.EmplaceWithHint(newStopPrice, Bid, order.symbolId, orderBook.trailingStopBidLevels.GetMapBegin())
-			orderBook.orders[order.id].levelPtr = newPtr
-			//Handle Order updated
+			newPtr := newTrailingStopBidLevels.Emplace(newStopPrice, Bid, order.symbolId)
+			order.levelPtr = newPtr
+			newPtr.AddOrder(order)
+			orderBook.onOrderUpdated(order, newPtr)
 		}
 	}
 	orderBook.trailingStopBidLevels = newTrailingStopBidLevels
@@ -286,7 +445,7 @@ func (orderBook *OrderBook) UpdateBidStopOrders() {
 }
 
 func (orderBook *OrderBook) UpdateAskStopOrders() {
-	if orderBook.trailingBidPrice <= orderBook.LastExecutedPriceBid() || orderBook.trailingStopBidLevels.IsEmpty() {
+	if orderBook.trailingBidPrice >= orderBook.LastExecutedPriceBid() || orderBook.trailingStopAskLevels.IsEmpty() {
 		orderBook.trailingBidPrice = orderBook.lastExecutedPrice
 		return
 	}
@@ -295,38 +454,80 @@ func (orderBook *OrderBook) UpdateAskStopOrders() {
 	trailingStopLevelsIt := orderBook.trailingStopAskLevels.levelMapIterator
 
 	for trailingStopLevelsIt.Next() {
-		for !trailingStopLevelsIt.Value().(*Level).Empty() {
-			var order *Order = trailingStopLevelsIt.Value().(*Level).Front()
+		level := trailingStopLevelsIt.Value().(*Level)
+		for !level.Empty() {
+			var order *Order = level.Front()
+			level.PopFront()
 			newStopPrice := orderBook.CalculateStopPrice(order)
-			newPtr := newTrailingStopAskLevels.EmplaceWithHint(newStopPrice, Bid, order.symbolId, orderBook.trailingStopBidLevels.GetMapBegin())
-			orderBook.orders[order.id].levelPtr = newPtr
-			//Handle Order updated
+			newPtr := newTrailingStopAskLevels.Emplace(newStopPrice, Ask, order.symbolId)
+			order.levelPtr = newPtr
+			newPtr.AddOrder(order)
+			orderBook.onOrderUpdated(order, newPtr)
 		}
 	}
-	orderBook.trailingStopBidLevels = newTrailingStopAskLevels
-	orderBook.trailingAskPrice = orderBook.lastExecutedPrice
+	orderBook.trailingStopAskLevels = newTrailingStopAskLevels
+	orderBook.trailingBidPrice = orderBook.lastExecutedPrice
 }
 
-func (orderBook *OrderBook) DelOrder(orderId uint64) {
-	orderBook.DeleteOrder(orderId, true)
+// Deletes every day and good till date order whose expire time is at or before
+// now, resting stops included. Returns the expired orders in id order.
+func (orderBook *OrderBook) ExpireOrders(now int64) []*Order {
+	return orderBook.deleteOrders(func(order *Order) bool { return order.IsExpired(now) })
+}
+
+// Deletes the resting orders selected picks in id order and returns them
+func (orderBook *OrderBook) deleteOrders(selected func(order *Order) bool) []*Order {
+	deleted := []*Order{}
+	for _, order := range orderBook.orders {
+		if selected(order) {
+			deleted = append(deleted, order)
+		}
+	}
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i].id < deleted[j].id })
+	for _, order := range deleted {
+		orderBook.DeleteOrder(order.id, true)
+	}
 	orderBook.ActivateStopOrders()
 	orderBook.ValidateOrderbook()
+	return deleted
+}
+
+func (orderBook *OrderBook) DelOrder(orderId uint64) error {
+	if err := orderBook.DeleteOrder(orderId, true); err != nil {
+		return err
+	}
+	orderBook.ActivateStopOrders()
+	orderBook.ValidateOrderbook()
+	return nil
 }
 
 // Use this if we dont want to immediately try activating stop orders
-func (orderBook *OrderBook) DeleteOrder(orderId uint64, noti bool) {
-	order := orderBook.orders[orderId]
+func (orderBook *OrderBook) DeleteOrder(orderId uint64, noti bool) error {
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return newOrderError(orderId, ErrUnknownOrder)
+	}
 	if order.orderType == Market {
-		fmt.Println("Should not be trying to delete a market order")
-		return
+		return newOrderError(orderId, ErrOrderNotResting)
 	}
-	level := order.levelPtr
+	level, err := orderBook.unlinkOrder(order)
+	if err != nil {
+		return err
+	}
+	delete(orderBook.orders, orderId)
 
 	if noti {
-		// Handle order deleted
+		orderBook.onOrderDeleted(order, level)
 	}
+	return nil
+}
 
-	level.DeleteOrder(order)
+// Takes the order out of its level, dropping the level once it is empty
+func (orderBook *OrderBook) unlinkOrder(order *Order) (*Level, error) {
+	level := order.levelPtr
+	if err := level.DeleteOrder(order); err != nil {
+		return nil, err
+	}
 	if level.Empty() {
 		switch order.orderType {
 		case Limit:
@@ -335,15 +536,13 @@ func (orderBook *OrderBook) DeleteOrder(orderId uint64, noti bool) {
 			} else {
 				orderBook.bidLevels.Delete(level.price)
 			}
-		case Stop:
-		case StopLimit:
+		case Stop, StopLimit:
 			if order.IsAsk() {
 				orderBook.stopAskLevels.Delete(level.price)
 			} else {
 				orderBook.stopBidLevels.Delete(level.price)
 			}
-		case TrailingStop:
-		case TrailingStopLimit:
+		case TrailingStop, TrailingStopLimit:
 			if order.IsAsk() {
 				orderBook.trailingStopAskLevels.Delete(level.price)
 			} else {
@@ -352,146 +551,350 @@ func (orderBook *OrderBook) DeleteOrder(orderId uint64, noti bool) {
 		default:
 			panic("Code should never reach this point, you are trying to delete a market order")
 		}
-		delete(orderBook.orders, orderId)
 	}
-
+	return level, nil
 }
 
-func (orderBook *OrderBook) ReplaceOrder(orderId uint64, newOrderId uint64, newPrice uint64) {
-	order := orderBook.orders[orderId]
+// Returns the replacement order along with any trades it generated
+func (orderBook *OrderBook) ReplaceOrder(orderId uint64, newOrderId uint64, newPrice uint64) (*Order, []Trade, error) {
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return nil, nil, newOrderError(orderId, ErrUnknownOrder)
+	}
+	if _, exists := orderBook.orders[newOrderId]; exists && newOrderId != orderId {
+		return nil, nil, newOrderError(newOrderId, ErrDuplicateOrderId)
+	}
 	newOrder := *order
 	newOrder.id = newOrderId
-	if order.IsStop() || order.IsStopLimit() || order.IsTrailingStop() {
-		newOrder.stopPrice = newPrice
+	newOrder.setAmendablePrice(newPrice)
+	return orderBook.requeueOrder(order, &newOrder)
+}
 
-	} else {
-		newOrder.price = newPrice
+// Changes the price and open quantity of a resting order. A size-down at the
+// same price keeps the order's time priority, a price change or size-up sends
+// it to the back of its new level where it may trade. Returns the order along
+// with any trades it generated.
+func (orderBook *OrderBook) ModifyOrder(orderId uint64, newPrice uint64, newQuantity uint64) (*Order, []Trade, error) {
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return nil, nil, newOrderError(orderId, ErrUnknownOrder)
 	}
-	orderBook.DeleteOrder(orderId, true)
-	orderBook.AddOrder(&newOrder)
-	orderBook.ActivateStopOrders()
-	orderBook.ValidateOrderbook()
+	if newQuantity == 0 {
+		return nil, nil, newOrderError(orderId, ErrInvalidQuantity)
+	}
+	if newPrice == order.amendablePrice() && newQuantity <= order.openQuantity {
+		if newQuantity < order.openQuantity {
+			orderBook.cancelRestingOrder(order, order.openQuantity-newQuantity)
+			orderBook.ActivateStopOrders()
+			orderBook.ValidateOrderbook()
+		}
+		return order, nil, nil
+	}
+	newOrder := *order
+	newOrder.setAmendablePrice(newPrice)
+	// Executed and cancelled quantity stay part of the order's total
+	newOrder.quantity = order.quantity - order.openQuantity + newQuantity
+	newOrder.openQuantity = newQuantity
+	return orderBook.requeueOrder(order, &newOrder)
+}
+
+// Swaps a resting order for its amended copy, which is added like a new order
+func (orderBook *OrderBook) requeueOrder(order *Order, newOrder *Order) (*Order, []Trade, error) {
+	newOrder.levelPtr = nil
+	// Pegs never follow each other, so the original resting does not change
+	// the price a pegged copy is checked at
+	if err := orderBook.checkOrder(newOrder, order); err != nil {
+		return nil, nil, err
+	}
+	group, grouped := orderBook.liveGroupOf(order.id)
+	if grouped {
+		delete(orderBook.orderGroups, order.id)
+	}
+	level, err := orderBook.unlinkOrder(order)
+	if err != nil {
+		return nil, nil, err
+	}
+	delete(orderBook.orders, order.id)
+	orderBook.onOrderReplaced(order, level, newOrder)
+	if grouped {
+		group.updateOrder(order.id, newOrder)
+		orderBook.orderGroups[newOrder.id] = group
+	}
+	trades, err := orderBook.AddOrder(newOrder)
+	return newOrder, trades, err
 }
 
 func (orderBook *OrderBook) Match(order *Order) {
+	// Auctions only trade when they uncross
+	if orderBook.inAuction {
+		return
+	}
 	if order.IsFillOrKill() && !orderBook.CanMatch(order) {
-		fmt.Println("Order is a Fill or Kill that could not be matched")
 		return
 	}
-	print("order being matched\n")
+	limits := orderBook.currentTradingLimits()
 	if order.IsAsk() {
-		orderBook.bidLevels.SetMapEnd()
-		bidLevelsIt := orderBook.bidLevels.levelMapIterator
 		askOrder := order
-		for bidLevelsIt.Prev() && bidLevelsIt.Key().(uint64) >= askOrder.price && !askOrder.IsFilled() {
-			bidLevel := bidLevelsIt.Value().(*Level)
+		for !askOrder.IsFilled() && !orderBook.bidLevels.IsEmpty() {
+			bidLevel := orderBook.GetBestBid()
+			if bidLevel.price < askOrder.price || !orderBook.checkTradePrice(limits, bidLevel.price) {
+				break
+			}
 			bidOrder := bidLevel.Front()
-			executingPrice := bidOrder.price
-			orderBook.ExecuteOrders(askOrder, bidOrder, executingPrice)
-			bidLevel.ReduceVolume(bidOrder.lastExecutedQuantity)
+			if orderBook.preventSelfTrade(askOrder, bidOrder) {
+				continue
+			}
+			orderBook.ExecuteOrders(askOrder, bidOrder, bidOrder.price, Ask)
 			if bidOrder.IsFilled() {
 				orderBook.DeleteOrder(bidOrder.id, true)
+			} else {
+				orderBook.replenishOrder(bidOrder)
 			}
-			orderBook.bidLevels.SetMapEnd()
 		}
 	}
 	if order.IsBid() {
-		orderBook.askLevels.SetMapBegin()
-		askLevelsIt := orderBook.askLevels.levelMapIterator
 		bidOrder := order
-		for askLevelsIt.Next() && askLevelsIt.Key().(uint64) <= bidOrder.price && !bidOrder.IsFilled() {
-			askLevel := askLevelsIt.Value().(*Level)
+		for !bidOrder.IsFilled() && !orderBook.askLevels.IsEmpty() {
+			askLevel := orderBook.GetBestAsk()
+			if askLevel.price > bidOrder.price || !orderBook.checkTradePrice(limits, askLevel.price) {
+				break
+			}
 			askOrder := askLevel.Front()
-			executingPrice := askOrder.price
-			orderBook.ExecuteOrders(askOrder, bidOrder, executingPrice)
-			askLevel.ReduceVolume(askOrder.lastExecutedQuantity)
+			if orderBook.preventSelfTrade(bidOrder, askOrder) {
+				continue
+			}
+			orderBook.ExecuteOrders(askOrder, bidOrder, askOrder.price, Bid)
 			if askOrder.IsFilled() {
 				orderBook.DeleteOrder(askOrder.id, true)
+			} else {
+				orderBook.replenishOrder(askOrder)
 			}
 		}
-		orderBook.askLevels.SetMapBegin()
 	}
 }
 
-func (orderBook *OrderBook) ExecuteOrderWithSpecifiedPrice(orderId uint64, quantity uint64, price uint64) {
-	order := orderBook.orders[orderId]
+func (orderBook *OrderBook) ExecuteOrderWithSpecifiedPrice(orderId uint64, quantity uint64, price uint64) error {
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return newOrderError(orderId, ErrUnknownOrder)
+	}
 	executingLevel := order.levelPtr
 	executingQuantity := simplemath.Min(quantity, order.GetOpenQuantity())
-	order.ExecuteOrder(executingQuantity, price)
+	preExecuteVisibleQuantity := order.GetVisibleQuantity()
+	if err := order.ExecuteOrder(executingQuantity, price); err != nil {
+		return err
+	}
 	orderBook.lastExecutedPrice = price
-	// Handle order executed
-	executingLevel.ReduceVolume(order.GetLastExecutedQuantity())
+	executingLevel.ReduceVolume(preExecuteVisibleQuantity - order.GetVisibleQuantity())
+	orderBook.onOrderExecuted(order, executingLevel, price, order.GetLastExecutedQuantity())
 	if order.IsFilled() {
 		orderBook.DeleteOrder(orderId, true)
+	} else {
+		orderBook.replenishOrder(order)
 	}
 	orderBook.ActivateStopOrders()
 	orderBook.ValidateOrderbook()
+	return nil
 }
 
 // For market orders
-func (orderBook *OrderBook) ExecuteOrderWithoutSpecifiedPrice(orderId uint64, quantity uint64) {
-	order := orderBook.orders[orderId]
+func (orderBook *OrderBook) ExecuteOrderWithoutSpecifiedPrice(orderId uint64, quantity uint64) error {
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return newOrderError(orderId, ErrUnknownOrder)
+	}
 	executingLevel := order.levelPtr
 	executingQuantity := simplemath.Min(quantity, order.GetOpenQuantity())
+	preExecuteVisibleQuantity := order.GetVisibleQuantity()
 	price := order.GetPrice()
-	order.ExecuteOrder(executingQuantity, price)
+	if err := order.ExecuteOrder(executingQuantity, price); err != nil {
+		return err
+	}
 	orderBook.lastExecutedPrice = price
-	// Handle order executed
-	executingLevel.ReduceVolume(order.GetLastExecutedQuantity())
+	executingLevel.ReduceVolume(preExecuteVisibleQuantity - order.GetVisibleQuantity())
+	orderBook.onOrderExecuted(order, executingLevel, price, order.GetLastExecutedQuantity())
 	if order.IsFilled() {
 		orderBook.DeleteOrder(orderId, true)
+	} else {
+		orderBook.replenishOrder(order)
 	}
 	orderBook.ActivateStopOrders()
 	orderBook.ValidateOrderbook()
+	return nil
 }
 
-func (orderBook *OrderBook) CancelOrder(orderId uint64, cancellingQuantity uint64) {
-	order := orderBook.orders[orderId]
+func (orderBook *OrderBook) CancelOrder(orderId uint64, cancellingQuantity uint64) error {
+	if cancellingQuantity == 0 {
+		return newOrderError(orderId, ErrInvalidQuantity)
+	}
+	order, exists := orderBook.orders[orderId]
+	if !exists {
+		return newOrderError(orderId, ErrUnknownOrder)
+	}
+	orderBook.cancelRestingOrder(order, cancellingQuantity)
+	orderBook.ActivateStopOrders()
+	orderBook.ValidateOrderbook()
+	return nil
+}
+
+// Reduces a resting order, deleting it once nothing is left
+func (orderBook *OrderBook) cancelRestingOrder(order *Order, cancellingQuantity uint64) {
 	cancellingLevel := order.levelPtr
 	preCancelQuantity := order.GetOpenQuantity()
+	preCancelVisibleQuantity := order.GetVisibleQuantity()
 	order.ReduceQuantity(cancellingQuantity)
-	// Handle order cancelled
-	cancellingLevel.ReduceVolume(preCancelQuantity - order.GetOpenQuantity())
+	cancellingLevel.ReduceVolume(preCancelVisibleQuantity - order.GetVisibleQuantity())
+	orderBook.onOrderCancelled(order, cancellingLevel, preCancelQuantity-order.GetOpenQuantity())
 	if order.IsFilled() {
-		orderBook.DeleteOrder(orderId, true)
+		orderBook.DeleteOrder(order.id, true)
 	}
-	orderBook.ActivateStopOrders()
-	orderBook.ValidateOrderbook()
 }
 
-func (orderBook *OrderBook) ExecuteOrders(askOrder *Order, bidOrder *Order, executingPrice uint64) {
-	matchedQuantity := simplemath.Min(askOrder.openQuantity, bidOrder.openQuantity)
+// Applies the aggressor's self trade prevention mode if the resting order
+// belongs to the same participant. Returns false if the two orders can trade,
+// otherwise at least one of them has been reduced or cancelled.
+func (orderBook *OrderBook) preventSelfTrade(aggressor *Order, resting *Order) bool {
+	if !aggressor.IsSelfTrade(resting) {
+		return false
+	}
+	// The aggressor is not in the book yet so it is only reduced here, a filled
+	// aggressor is deleted once matching stops
+	cancelAggressor := func(quantity uint64) {
+		preCancelQuantity := aggressor.GetOpenQuantity()
+		aggressor.ReduceQuantity(quantity)
+		orderBook.onOrderCancelled(aggressor, nil, preCancelQuantity-aggressor.GetOpenQuantity())
+	}
+	switch aggressor.selfTradePrevention {
+	case CancelResting:
+		orderBook.DeleteOrder(resting.id, true)
+	case CancelAggressor:
+		cancelAggressor(aggressor.openQuantity)
+	case CancelBoth:
+		orderBook.DeleteOrder(resting.id, true)
+		cancelAggressor(aggressor.openQuantity)
+	case DecrementAndCancel:
+		quantity := simplemath.Min(aggressor.openQuantity, resting.openQuantity)
+		orderBook.cancelRestingOrder(resting, quantity)
+		cancelAggressor(quantity)
+	}
+	return true
+}
+
+func (orderBook *OrderBook) ExecuteOrders(askOrder *Order, bidOrder *Order, executingPrice uint64, aggressorSide Side) {
+	// The matched quantity never exceeds either open quantity so neither execution can fail
+	matchedQuantity := simplemath.Min(matchableQuantity(askOrder), matchableQuantity(bidOrder))
 	askOrder.ExecuteOrder(matchedQuantity, executingPrice)
 	bidOrder.ExecuteOrder(matchedQuantity, executingPrice)
-	// Handle Ask order execution
-	// Handle Bid order execution
-	print("Order being executed at price:\n")
-	print(executingPrice)
-	print("\n")
+	// Only the resting side of the match has a level to update
+	if askOrder.levelPtr != nil {
+		askOrder.levelPtr.ReduceVolume(matchedQuantity)
+	}
+	if bidOrder.levelPtr != nil {
+		bidOrder.levelPtr.ReduceVolume(matchedQuantity)
+	}
+	orderBook.onOrderExecuted(askOrder, askOrder.levelPtr, executingPrice, matchedQuantity)
+	orderBook.onOrderExecuted(bidOrder, bidOrder.levelPtr, executingPrice, matchedQuantity)
 	orderBook.lastExecutedPrice = executingPrice
+
+	orderBook.lastTradeId++
+	orderBook.sequenceNumber++
+	trade := Trade{
+		tradeId:        orderBook.lastTradeId,
+		symbolId:       orderBook.symbolId,
+		bidOrderId:     bidOrder.id,
+		askOrderId:     askOrder.id,
+		aggressorSide:  aggressorSide,
+		price:          executingPrice,
+		quantity:       matchedQuantity,
+		sequenceNumber: orderBook.sequenceNumber,
+		timestamp:      time.Now().UnixNano(),
+	}
+	orderBook.trades = append(orderBook.trades, trade)
+	orderBook.eventHandler.OnTrade(&trade)
+}
+
+// Returns true if the limit order would take liquidity at its price
+func (orderBook *OrderBook) Crosses(order *Order) bool {
+	if order.IsAsk() {
+		return !orderBook.bidLevels.IsEmpty() && orderBook.GetBestBid().price >= order.price
+	}
+	return !orderBook.askLevels.IsEmpty() && orderBook.GetBestAsk().price <= order.price
 }
 
+// Moves a crossing post only order one tick behind the best opposite price,
+// returns false if it has to be rejected instead. Only called once Crosses
+// found the opposite side is not empty.
+func (orderBook *OrderBook) repricePostOnly(order *Order) bool {
+	if order.postOnly != PostOnlyReprice {
+		return false
+	}
+	if order.IsAsk() {
+		order.price = orderBook.GetBestBid().price + orderBook.tickSize
+		return true
+	}
+	// There is no price a tick below the best ask
+	bestAsk := orderBook.GetBestAsk().price
+	if bestAsk <= orderBook.tickSize {
+		return false
+	}
+	order.price = bestAsk - orderBook.tickSize
+	return true
+}
+
+// A resting iceberg only trades its visible slice in a single match, the
+// aggressor can trade all of its open quantity
+func matchableQuantity(order *Order) uint64 {
+	if order.levelPtr != nil {
+		return order.GetVisibleQuantity()
+	}
+	return order.openQuantity
+}
+
+// Shows the next slice of an iceberg whose visible quantity has been used up,
+// the order goes to the back of its level and loses its time priority
+func (orderBook *OrderBook) replenishOrder(order *Order) {
+	if !order.IsIceberg() || order.IsFilled() || order.visibleQuantity > 0 {
+		return
+	}
+	order.refreshVisibleQuantity()
+	order.levelPtr.ReplenishOrder(order)
+	orderBook.onOrderUpdated(order, order.levelPtr)
+}
+
+// Hidden reserves count towards the available quantity as icebergs keep
+// replenishing until they are filled, orders self trade prevention would
+// cancel do not. Levels outside the price bands are out of reach, a fill or
+// kill order that needs them is killed rather than halting the book.
 func (orderBook *OrderBook) CanMatch(order *Order) bool {
 	var availableQuantity uint64 = 0
+	limits := orderBook.currentTradingLimits()
 	if order.IsAsk() {
 		orderBook.bidLevels.SetMapEnd()
 		bidLevelsIt := orderBook.bidLevels.levelMapIterator
-		for bidLevelsIt.Prev() && bidLevelsIt.Key().(uint64) >= order.price {
+		for bidLevelsIt.Prev() && bidLevelsIt.Key().(uint64) >= order.price && limits.allows(bidLevelsIt.Key().(uint64)) {
 			var quantityNeeded uint64 = order.openQuantity - availableQuantity
-			availableQuantity += simplemath.Min(quantityNeeded, bidLevelsIt.Value().(*Level).volume)
+			levelQuantity, reachable := bidLevelsIt.Value().(*Level).availableQuantity(order)
+			availableQuantity += simplemath.Min(quantityNeeded, levelQuantity)
 			if availableQuantity >= order.openQuantity {
 				return true
 			}
+			if !reachable {
+				return false
+			}
 		}
 	} else {
 		orderBook.askLevels.SetMapBegin()
 		askLevelsIt := orderBook.askLevels.levelMapIterator
-		for askLevelsIt.Next() && askLevelsIt.Key().(uint64) <= order.price {
+		for askLevelsIt.Next() && askLevelsIt.Key().(uint64) <= order.price && limits.allows(askLevelsIt.Key().(uint64)) {
 			var quantityNeeded uint64 = order.openQuantity - availableQuantity
-			availableQuantity += simplemath.Min(quantityNeeded, askLevelsIt.Value().(*Level).volume)
+			levelQuantity, reachable := askLevelsIt.Value().(*Level).availableQuantity(order)
+			availableQuantity += simplemath.Min(quantityNeeded, levelQuantity)
 			if availableQuantity >= order.openQuantity {
 				return true
 			}
+			if !reachable {
+				return false
+			}
 		}
 	}
 	return false
@@ -518,7 +921,6 @@ func (orderBook *OrderBook) GetBestBid() *Level {
 	bestBid := orderBook.bidLevels.GetMapEnd()
 	// Default
 	if bestBid == nil {
-		print("No Bid orders exist - a default value was used\n")
 		return &Level{
 			levelSide: Bid,
 			price:     0,
@@ -534,9 +936,8 @@ func (orderBook *OrderBook) GetBestAsk() *Level {
 
 	bestAsk := orderBook.askLevels.GetMapBegin()
 
-	// Default - can cosider logging defaults
+	// Default
 	if bestAsk == nil {
-		print("No Ask orders exist - a default value was used\n")
 		return &Level{
 			levelSide: Ask,
 			price:     math.MaxUint64,
@@ -561,7 +962,8 @@ func (orderBook *OrderBook) ValidateLimitOrders() {
 	} else {
 		currBestBid = orderBook.bidLevels.GetMapEnd().Value.(*Level).price
 	}
-	if !(currBestAsk >= currBestBid) {
+	// Auctions let the book cross until they uncross
+	if !orderBook.inAuction && !(currBestAsk >= currBestBid) {
 		print(currBestAsk)
 		print(currBestBid)
 		panic("Best bid price should never be lower than best ask price!")
@@ -626,7 +1028,8 @@ func (orderBook *OrderBook) ValidateStopOrders() {
 		if level.Empty() {
 			panic("There should be no empty stop levels in the orderbook")
 		}
-		if level.price > orderBook.lastExecutedPrice {
+		// Stops are held back while an auction runs
+		if !orderBook.inAuction && level.price > orderBook.lastExecutedPrice {
 			panic("Stop ask order has a stop price that is greater than the last executed price, but was not stopped")
 		}
 		if level.price != itr.Key().(uint64) {
@@ -653,7 +1056,7 @@ func (orderBook *OrderBook) ValidateStopOrders() {
 		if level.Empty() {
 			panic("There should be no empty stop levels in the orderbook")
 		}
-		if level.price < orderBook.lastExecutedPrice {
+		if !orderBook.inAuction && level.price < orderBook.lastExecutedPrice {
 			panic("Stop bid order has a stop price that is less than the last executed price, but was not stopped")
 		}
 		if level.price != itr.Key().(uint64) {
@@ -683,7 +1086,8 @@ func (orderBook *OrderBook) ValidateTrailingStopOrders() {
 		if level.Empty() {
 			panic("There should be no empty trailing stop levels in the orderbook")
 		}
-		if level.price > orderBook.lastExecutedPrice {
+		// Stops are held back while an auction runs
+		if !orderBook.inAuction && level.price > orderBook.lastExecutedPrice {
 			panic("trailing stop ask order has a trailing stop price that is greater than the last executed price, but was not stopped")
 		}
 		if level.price != itr.Key().(uint64) {
@@ -710,7 +1114,7 @@ func (orderBook *OrderBook) ValidateTrailingStopOrders() {
 		if level.Empty() {
 			panic("There should be no empty trailing stop levels in the orderbook")
 		}
-		if level.price < orderBook.lastExecutedPrice {
+		if !orderBook.inAuction && level.price < orderBook.lastExecutedPrice {
 			panic("Trailing stop bid order has a stop price that is less than the last executed price, but was not stopped")
 		}
 		if level.price != itr.Key().(uint64) {
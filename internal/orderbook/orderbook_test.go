@@ -0,0 +1,322 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+// Adds orders that must be accepted
+func addOrders(t *testing.T, orderBook *OrderBook, orders ...*Order) {
+	t.Helper()
+	for _, order := range orders {
+		if _, err := orderBook.AddOrder(order); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Trades quantity 1 at the given price between two fresh orders
+func tradeAt(t *testing.T, orderBook *OrderBook, askId uint64, bidId uint64, price uint64) {
+	t.Helper()
+	order := mustOrder(t)
+	addOrders(t, orderBook,
+		order(LimitAskOrder(askId, 0, 1, price, GoodTillCancel)),
+		order(LimitBidOrder(bidId, 0, 1, price, GoodTillCancel)),
+	)
+}
+
+func TestDeleteOrder(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	addOrders(t, orderBook,
+		order(LimitBidOrder(1, 0, 5, 99, GoodTillCancel)),
+		order(LimitBidOrder(2, 0, 5, 99, GoodTillCancel)),
+	)
+
+	// The level still has an order in it but the deleted one is gone
+	if err := orderBook.DelOrder(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := orderBook.orders[1]; exists {
+		t.Error("expected order 1 to be forgotten")
+	}
+	if level, exists := orderBook.bidLevels.Get(99); !exists || level.GetVolume() != 5 {
+		t.Fatalf("expected 5 left bid at 99, got %v", level)
+	}
+
+	// Empty stop levels are removed like limit levels
+	tradeAt(t, orderBook, 3, 4, 100)
+	addOrders(t, orderBook,
+		order(StopAskOrder(5, 0, 5, 95, GoodTillCancel)),
+		order(StopLimitBidOrder(6, 0, 5, 106, 105, GoodTillCancel)),
+	)
+	if err := orderBook.DelOrder(5); err != nil {
+		t.Fatal(err)
+	}
+	if err := orderBook.DelOrder(6); err != nil {
+		t.Fatal(err)
+	}
+	if !orderBook.stopAskLevels.IsEmpty() || !orderBook.stopBidLevels.IsEmpty() {
+		t.Errorf("expected no stop levels, got asks %v and bids %v", orderBook.stopAskLevels, orderBook.stopBidLevels)
+	}
+	if len(orderBook.orders) != 1 {
+		t.Errorf("expected only order 2 to be left, got %v", orderBook.orders)
+	}
+}
+
+func TestStopOrdersTrigger(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	tradeAt(t, orderBook, 1, 2, 100)
+	addOrders(t, orderBook,
+		order(StopBidOrder(3, 0, 2, 101, GoodTillCancel)),
+		order(StopLimitAskOrder(4, 0, 2, 97, 98, GoodTillCancel)),
+	)
+
+	// The stop buys what is left at 101 once it trades there
+	addOrders(t, orderBook,
+		order(LimitAskOrder(5, 0, 3, 101, GoodTillCancel)),
+		order(LimitBidOrder(6, 0, 1, 101, GoodTillCancel)),
+	)
+	if _, exists := orderBook.orders[3]; exists || !orderBook.stopBidLevels.IsEmpty() {
+		t.Fatalf("expected the bid stop to trigger, got %v", orderBook.stopBidLevels)
+	}
+	if _, exists := orderBook.orders[5]; exists {
+		t.Fatal("expected the stop to fill the rest of the ask")
+	}
+
+	// The stop limit rests at its limit price once triggered
+	addOrders(t, orderBook,
+		order(LimitBidOrder(7, 0, 1, 98, GoodTillCancel)),
+		order(LimitAskOrder(8, 0, 1, 98, GoodTillCancel)),
+	)
+	if !orderBook.stopAskLevels.IsEmpty() {
+		t.Fatalf("expected the ask stop limit to trigger, got %v", orderBook.stopAskLevels)
+	}
+	if order, exists := orderBook.orders[4]; !exists || !order.IsLimit() || order.levelPtr != orderBook.GetBestAsk() || order.GetPrice() != 97 {
+		t.Fatalf("expected order 4 to rest as a limit at 97, got %v", order)
+	}
+}
+
+func TestTrailingStopOrders(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	tradeAt(t, orderBook, 1, 2, 100)
+	addOrders(t, orderBook,
+		order(TrailingStopBidOrder(3, 0, 5, 5, GoodTillCancel)),
+		order(TrailingStopAskOrder(4, 0, 5, 5, GoodTillCancel)),
+	)
+
+	expectStop := func(levels *LevelMap, orderId uint64, stopPrice uint64) {
+		t.Helper()
+		level, exists := levels.Get(stopPrice)
+		if !exists || level.Empty() || level.Front().GetId() != orderId || orderBook.orders[orderId].levelPtr != level {
+			t.Fatalf("expected order %d to rest at %d, got %v", orderId, stopPrice, levels)
+		}
+	}
+	// Each side rests at its own stop price
+	expectStop(orderBook.trailingStopBidLevels, 3, 105)
+	expectStop(orderBook.trailingStopAskLevels, 4, 95)
+
+	// A bid stop follows the price down
+	tradeAt(t, orderBook, 5, 6, 98)
+	expectStop(orderBook.trailingStopBidLevels, 3, 103)
+	expectStop(orderBook.trailingStopAskLevels, 4, 95)
+
+	// An ask stop follows the price up and the bid stop triggers
+	tradeAt(t, orderBook, 7, 8, 103)
+	if _, exists := orderBook.orders[3]; exists || !orderBook.trailingStopBidLevels.IsEmpty() {
+		t.Fatalf("expected the bid stop to trigger, got %v", orderBook.trailingStopBidLevels)
+	}
+	expectStop(orderBook.trailingStopAskLevels, 4, 98)
+}
+
+// Every order type and time in force combination for a bid of 10 that can
+// only fill 5 at 101. Stop orders use stop prices that trigger straight away.
+func TestOrderTypeTimeInForceMatrix(t *testing.T) {
+	const orderId = 10
+	newOrders := map[OrderType]func(OrderTimeInForce) (Order, error){
+		Limit: func(timeInForce OrderTimeInForce) (Order, error) {
+			return LimitBidOrder(orderId, 0, 10, 101, timeInForce)
+		},
+		Market: func(timeInForce OrderTimeInForce) (Order, error) {
+			return MarketBidOrder(orderId, 0, 10, timeInForce)
+		},
+		Stop: func(timeInForce OrderTimeInForce) (Order, error) {
+			return StopBidOrder(orderId, 0, 10, 100, timeInForce)
+		},
+		StopLimit: func(timeInForce OrderTimeInForce) (Order, error) {
+			return StopLimitBidOrder(orderId, 0, 10, 101, 100, timeInForce)
+		},
+		TrailingStop: func(timeInForce OrderTimeInForce) (Order, error) {
+			return TrailingStopBidOrder(orderId, 0, 10, 0, timeInForce)
+		},
+		TrailingStopLimit: func(timeInForce OrderTimeInForce) (Order, error) {
+			return TrailingStopLimitBidOrder(orderId, 0, 10, 101, 0, timeInForce)
+		},
+	}
+
+	type outcome struct {
+		invalid   bool
+		executed  uint64
+		cancelled uint64
+		resting   bool
+	}
+	invalid := outcome{invalid: true}
+	rests := outcome{executed: 5, resting: true}
+	cancelsRemainder := outcome{executed: 5, cancelled: 5}
+	killed := outcome{cancelled: 10}
+
+	expected := map[OrderType]map[OrderTimeInForce]outcome{
+		Limit: {
+			GoodTillCancel: rests, ImmediateOrCancel: cancelsRemainder, FillOrKill: killed, Day: rests, GoodTillDate: rests,
+		},
+		Market: {
+			GoodTillCancel: invalid, ImmediateOrCancel: cancelsRemainder, FillOrKill: killed, Day: invalid, GoodTillDate: invalid,
+		},
+		// Triggered stops become market orders, which never rest
+		Stop: {
+			GoodTillCancel: cancelsRemainder, ImmediateOrCancel: cancelsRemainder, FillOrKill: invalid, Day: cancelsRemainder, GoodTillDate: cancelsRemainder,
+		},
+		StopLimit: {
+			GoodTillCancel: rests, ImmediateOrCancel: cancelsRemainder, FillOrKill: killed, Day: rests, GoodTillDate: rests,
+		},
+		TrailingStop: {
+			GoodTillCancel: cancelsRemainder, ImmediateOrCancel: cancelsRemainder, FillOrKill: invalid, Day: cancelsRemainder, GoodTillDate: cancelsRemainder,
+		},
+		TrailingStopLimit: {
+			GoodTillCancel: rests, ImmediateOrCancel: cancelsRemainder, FillOrKill: killed, Day: rests, GoodTillDate: rests,
+		},
+	}
+
+	for orderType := OrderType(Limit); orderType <= TrailingStopLimit; orderType++ {
+		for timeInForce := OrderTimeInForce(GoodTillCancel); timeInForce <= GoodTillDate; timeInForce++ {
+			want := expected[orderType][timeInForce]
+			t.Run(orderType.String()+"/"+timeInForce.String(), func(t *testing.T) {
+				handler := &recordingEventHandler{orderId: orderId}
+				orderBook := newMatrixOrderBook(t, handler)
+
+				order, err := newOrders[orderType](timeInForce)
+				if err == nil {
+					if timeInForce == Day || timeInForce == GoodTillDate {
+						order.SetExpireTime(1)
+					}
+					_, err = orderBook.AddOrder(&order)
+				}
+				if want.invalid {
+					if !errors.Is(err, ErrInvalidTimeInForce) {
+						t.Fatalf("expected ErrInvalidTimeInForce, got %v", err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if order.GetExecutedQuantity() != want.executed {
+					t.Errorf("executed %d, want %d", order.GetExecutedQuantity(), want.executed)
+				}
+				if handler.cancelled != want.cancelled {
+					t.Errorf("cancelled %d, want %d", handler.cancelled, want.cancelled)
+				}
+				_, resting := orderBook.GetOrder(orderId)
+				if resting != want.resting {
+					t.Errorf("resting %v, want %v", resting, want.resting)
+				}
+				if handler.deleted == want.resting {
+					t.Errorf("deleted %v, want %v", handler.deleted, !want.resting)
+				}
+				if want.resting {
+					if order.GetOpenQuantity() != 5 || orderBook.GetBestBid().GetVolume() != 5 || orderBook.GetBestBid().GetPrice() != 101 {
+						t.Errorf("expected 5 resting at 101, got %v", orderBook.GetBestBid())
+					}
+				} else if order.GetOpenQuantity() != 0 {
+					t.Errorf("open quantity %d left on an order that is not resting", order.GetOpenQuantity())
+				}
+			})
+		}
+	}
+}
+
+// An amend the book rejects leaves the original order resting where it was
+func TestRejectedAmendKeepsOrder(t *testing.T) {
+	order := mustOrder(t)
+	tests := []struct {
+		name  string
+		setup func(orderBook *OrderBook) *Order
+		// Price and quantity the order is modified to
+		price    uint64
+		quantity uint64
+		want     error
+	}{
+		{
+			name: "post only during an auction",
+			setup: func(orderBook *OrderBook) *Order {
+				newOrder := order(LimitBidOrder(10, 0, 5, 98, GoodTillCancel))
+				newOrder.SetPostOnly(PostOnlyReject)
+				if _, err := orderBook.AddOrder(newOrder); err != nil {
+					t.Fatal(err)
+				}
+				if err := orderBook.StartAuction(); err != nil {
+					t.Fatal(err)
+				}
+				return newOrder
+			},
+			price: 97, quantity: 5, want: ErrNotAllowedInAuction,
+		},
+		{
+			name: "post only that would cross",
+			setup: func(orderBook *OrderBook) *Order {
+				newOrder := order(LimitBidOrder(10, 0, 5, 98, GoodTillCancel))
+				newOrder.SetPostOnly(PostOnlyReject)
+				if _, err := orderBook.AddOrder(newOrder); err != nil {
+					t.Fatal(err)
+				}
+				return newOrder
+			},
+			price: 101, quantity: 5, want: ErrPostOnlyWouldCross,
+		},
+		{
+			name: "iceberg smaller than its display",
+			setup: func(orderBook *OrderBook) *Order {
+				newOrder := order(LimitBidOrder(10, 0, 100, 98, GoodTillCancel))
+				newOrder.SetDisplayQuantity(50)
+				if _, err := orderBook.AddOrder(newOrder); err != nil {
+					t.Fatal(err)
+				}
+				return newOrder
+			},
+			price: 97, quantity: 10, want: ErrInvalidDisplayQuantity,
+		},
+		{
+			name: "peg whose reference side emptied",
+			setup: func(orderBook *OrderBook) *Order {
+				newOrder := order(LimitBidOrder(10, 0, 5, 0, GoodTillCancel))
+				addPeggedOrder(t, orderBook, newOrder, MarketPeg, -1)
+				if err := orderBook.DelOrder(3); err != nil {
+					t.Fatal(err)
+				}
+				return newOrder
+			},
+			price: 100, quantity: 10, want: ErrNoPegPrice,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Trade at 100, 5 asked at 101 and 5 bid at 99
+			orderBook := newMatrixOrderBook(t, NullEventHandler{})
+			original := test.setup(orderBook)
+			price, openQuantity := original.GetPrice(), original.GetOpenQuantity()
+			if _, _, err := orderBook.ModifyOrder(10, test.price, test.quantity); !errors.Is(err, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, err)
+			}
+			resting, exists := orderBook.GetOrder(10)
+			if !exists || resting != original || resting.GetPrice() != price || resting.GetOpenQuantity() != openQuantity {
+				t.Fatal("the rejected modify changed the resting order")
+			}
+			if level := resting.GetLevelPtr(); level == nil || level.orders.Len() == 0 {
+				t.Error("the order is no longer in its level")
+			}
+		})
+	}
+}
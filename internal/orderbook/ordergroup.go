@@ -0,0 +1,325 @@
+package orderbook
+
+type OrderGroupType int
+
+const (
+	// A fill on any leg cancels the other legs
+	OneCancelsOther = 0
+	// Children enter the book once the parent has completely filled
+	OneTriggersOther = 1
+	// One triggers other where the children then cancel each other, e.g. a
+	// take profit limit and a protective stop
+	Bracket = 2
+)
+
+func (groupType OrderGroupType) String() string {
+	switch groupType {
+	case OneCancelsOther:
+		return "One Cancels Other"
+	case OneTriggersOther:
+		return "One Triggers Other"
+	case Bracket:
+		return "Bracket"
+	default:
+		return "Unknown Order Group Type"
+	}
+}
+
+// Number of finished groups each book remembers, older ones are forgotten
+const finishedGroupHistorySize = 1024
+
+type OrderGroupState int
+
+const (
+	// Waiting for the parent to fill
+	GroupPending = 0
+	// Legs are working and cancel each other
+	GroupActive = 1
+	// A leg filled, or the children of a one triggers other were activated
+	GroupCompleted = 2
+	// The group ended without a fill
+	GroupCancelled = 3
+)
+
+func (groupState OrderGroupState) String() string {
+	switch groupState {
+	case GroupPending:
+		return "Pending"
+	case GroupActive:
+		return "Active"
+	case GroupCompleted:
+		return "Completed"
+	case GroupCancelled:
+		return "Cancelled"
+	default:
+		return "Unknown Order Group State"
+	}
+}
+
+// OrderGroup ties orders together so events on one act on the others. Orders
+// are kept in submission order, for one triggers other and bracket groups the
+// parent comes first.
+type OrderGroup struct {
+	groupId   uint64
+	groupType OrderGroupType
+	state     OrderGroupState
+	orders    []*Order
+	// Set once the parent's last open quantity has executed
+	parentFilled bool
+	// Set once the group is in the book's finished history
+	finished bool
+	// Ids of orders that have left the book, they can be used again by orders
+	// outside the group
+	left map[uint64]bool
+}
+
+func (group *OrderGroup) GetGroupId() uint64 {
+	return group.groupId
+}
+
+func (group *OrderGroup) GetGroupType() OrderGroupType {
+	return group.groupType
+}
+
+func (group *OrderGroup) GetState() OrderGroupState {
+	return group.state
+}
+
+func (group *OrderGroup) GetOrderIds() []uint64 {
+	orderIds := make([]uint64, 0, len(group.orders))
+	for _, order := range group.orders {
+		orderIds = append(orderIds, order.id)
+	}
+	return orderIds
+}
+
+// Latest state of one of the group's orders, including orders that have left
+// the book or have not entered it yet
+func (group *OrderGroup) GetOrder(orderId uint64) (*Order, bool) {
+	for _, order := range group.orders {
+		if order.id == orderId {
+			return order, true
+		}
+	}
+	return nil, false
+}
+
+// True for children still waiting for their parent to fill
+func (group *OrderGroup) IsPending(orderId uint64) bool {
+	return group.state == GroupPending && group.isChild(orderId)
+}
+
+func (group *OrderGroup) hasParent() bool {
+	return group.groupType == OneTriggersOther || group.groupType == Bracket
+}
+
+func (group *OrderGroup) isChild(orderId uint64) bool {
+	return group.hasParent() && group.orders[0].id != orderId
+}
+
+// The orders that cancel each other while the group is active
+func (group *OrderGroup) legs() []*Order {
+	if group.hasParent() {
+		return group.orders[1:]
+	}
+	return group.orders
+}
+
+func (group *OrderGroup) updateOrder(orderId uint64, order *Order) {
+	for i := range group.orders {
+		if group.orders[i].id == orderId {
+			group.orders[i] = order
+		}
+	}
+}
+
+type orderGroupEvent struct {
+	group    *OrderGroup
+	order    *Order
+	executed bool
+}
+
+// AddOrderGroup adds every order of a new group. For one cancels other groups
+// all orders are legs, otherwise the first order is the parent and only it
+// enters the book now. Returns every trade generated, like AddOrder.
+func (orderBook *OrderBook) AddOrderGroup(groupId uint64, groupType OrderGroupType, orders []*Order) ([]Trade, error) {
+	if _, exists := orderBook.groups[groupId]; exists {
+		return nil, ErrDuplicateGroupId
+	}
+	group := &OrderGroup{groupId: groupId, groupType: groupType, orders: orders, left: make(map[uint64]bool)}
+	switch {
+	case groupType == OneCancelsOther && len(orders) >= 2:
+		group.state = GroupActive
+	case (groupType == OneTriggersOther || groupType == Bracket) && len(orders) >= 2:
+		group.state = GroupPending
+	default:
+		return nil, ErrInvalidOrderGroup
+	}
+	orderIds := make(map[uint64]bool)
+	for _, order := range orders {
+		if orderIds[order.id] {
+			return nil, newOrderError(order.id, ErrDuplicateOrderId)
+		}
+		orderIds[order.id] = true
+		if err := orderBook.checkNewOrder(order); err != nil {
+			return nil, err
+		}
+	}
+
+	orderBook.groups[groupId] = group
+	for _, order := range orders {
+		orderBook.orderGroups[order.id] = group
+	}
+	orderBook.trades = nil
+	if group.hasParent() {
+		orderBook.addOrder(orders[0])
+	} else {
+		orderBook.addGroupLegs(group, orders)
+	}
+	orderBook.ActivateStopOrders()
+	orderBook.ValidateOrderbook()
+	trades := orderBook.trades
+	orderBook.trades = nil
+	return trades, nil
+}
+
+// An id used again by a resting order no longer finds the group it left
+func (orderBook *OrderBook) GetOrderGroup(orderId uint64) (*OrderGroup, bool) {
+	group, exists := orderBook.orderGroups[orderId]
+	if _, resting := orderBook.orders[orderId]; exists && resting && group.left[orderId] {
+		return nil, false
+	}
+	return group, exists
+}
+
+// The group an order id still belongs to, once the group's order has left the
+// book the id belongs to whichever order uses it next
+func (orderBook *OrderBook) liveGroupOf(orderId uint64) (*OrderGroup, bool) {
+	group, exists := orderBook.orderGroups[orderId]
+	if !exists || group.left[orderId] {
+		return nil, false
+	}
+	return group, true
+}
+
+// Legs are added one at a time so a leg that trades on entry cancels the
+// ones after it before they reach the book
+func (orderBook *OrderBook) addGroupLegs(group *OrderGroup, legs []*Order) {
+	for _, leg := range legs {
+		if group.state != GroupActive {
+			orderBook.cancelRemainder(leg)
+			continue
+		}
+		orderBook.addOrder(leg)
+		orderBook.processOrderGroups()
+	}
+}
+
+// Called for every execution and deletion, the group acts on it once the
+// book is done matching
+func (orderBook *OrderBook) recordGroupEvent(order *Order, executed bool) {
+	if group, exists := orderBook.liveGroupOf(order.id); exists {
+		orderBook.orderGroupEvents = append(orderBook.orderGroupEvents, orderGroupEvent{group: group, order: order, executed: executed})
+		if !executed {
+			group.left[order.id] = true
+		}
+	}
+}
+
+// Stop activation and amends hand the book a new copy of an order, the group
+// follows it so status queries see the order being worked
+func (orderBook *OrderBook) trackGroupOrder(order *Order) {
+	if group, exists := orderBook.liveGroupOf(order.id); exists {
+		group.updateOrder(order.id, order)
+	}
+}
+
+// Handles the recorded group events, returns true if there were any
+func (orderBook *OrderBook) processOrderGroups() bool {
+	processed := len(orderBook.orderGroupEvents) > 0
+	for len(orderBook.orderGroupEvents) > 0 {
+		event := orderBook.orderGroupEvents[0]
+		orderBook.orderGroupEvents = orderBook.orderGroupEvents[1:]
+		orderBook.handleGroupEvent(event)
+		orderBook.finishOrderGroup(event.group)
+	}
+	return processed
+}
+
+// Moves a group that can no longer change into the finished history, which
+// forgets the oldest group once it is full. A group is done once it has
+// completed or been cancelled and none of its orders are left in the book.
+func (orderBook *OrderBook) finishOrderGroup(group *OrderGroup) {
+	if group.finished || group.state != GroupCompleted && group.state != GroupCancelled {
+		return
+	}
+	for _, order := range group.orders {
+		if _, resting := orderBook.orders[order.id]; resting && !group.left[order.id] {
+			return
+		}
+	}
+	group.finished = true
+	orderBook.finishedGroups = append(orderBook.finishedGroups, group)
+	if len(orderBook.finishedGroups) <= finishedGroupHistorySize {
+		return
+	}
+	oldest := orderBook.finishedGroups[0]
+	orderBook.finishedGroups = orderBook.finishedGroups[1:]
+	delete(orderBook.groups, oldest.groupId)
+	for _, order := range oldest.orders {
+		// Ids of orders that have left the book can be used again
+		if orderBook.orderGroups[order.id] == oldest {
+			delete(orderBook.orderGroups, order.id)
+		}
+	}
+}
+
+func (orderBook *OrderBook) handleGroupEvent(event orderGroupEvent) {
+	group := event.group
+	group.updateOrder(event.order.id, event.order)
+	switch group.state {
+	case GroupPending:
+		if group.isChild(event.order.id) {
+			return
+		}
+		if event.executed {
+			group.parentFilled = event.order.IsFilled()
+			return
+		}
+		// The parent has left the book, its children follow it in if it filled
+		if !group.parentFilled {
+			group.state = GroupCancelled
+			for _, child := range group.legs() {
+				orderBook.cancelRemainder(child)
+			}
+			return
+		}
+		if group.groupType == Bracket {
+			group.state = GroupActive
+			orderBook.addGroupLegs(group, group.legs())
+			return
+		}
+		group.state = GroupCompleted
+		for _, child := range group.legs() {
+			orderBook.addOrder(child)
+		}
+
+	case GroupActive:
+		if group.hasParent() && !group.isChild(event.order.id) {
+			return
+		}
+		if event.executed {
+			group.state = GroupCompleted
+		} else {
+			group.state = GroupCancelled
+		}
+		for _, leg := range group.legs() {
+			if leg.id == event.order.id {
+				continue
+			}
+			if _, resting := orderBook.orders[leg.id]; resting {
+				orderBook.DeleteOrder(leg.id, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package orderbook
+
+import "testing"
+
+func expectGroupState(t *testing.T, orderBook *OrderBook, orderId uint64, want OrderGroupState) {
+	t.Helper()
+	group, exists := orderBook.GetOrderGroup(orderId)
+	if !exists {
+		t.Fatalf("order %d has no group", orderId)
+	}
+	if group.GetState() != want {
+		t.Fatalf("group state %v, want %v", group.GetState(), want)
+	}
+}
+
+func TestOneCancelsOther(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	// Take profit at 110 and a protective stop at 90 on a long position
+	_, err := orderBook.AddOrderGroup(1, OneCancelsOther, []*Order{
+		order(LimitAskOrder(10, 0, 5, 110, GoodTillCancel)),
+		order(StopAskOrder(11, 0, 5, 90, GoodTillCancel)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupActive)
+
+	// Amending a leg requeues it without cancelling the group
+	if _, _, err := orderBook.ModifyOrder(10, 112, 5); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupActive)
+
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 7, 112, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupCompleted)
+	if _, resting := orderBook.GetOrder(11); resting {
+		t.Error("stop leg still resting after the take profit traded")
+	}
+}
+
+func TestBracket(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	_, err := orderBook.AddOrderGroup(1, Bracket, []*Order{
+		order(LimitBidOrder(10, 0, 5, 100, GoodTillCancel)),
+		order(LimitAskOrder(11, 0, 5, 120, GoodTillCancel)),
+		order(StopAskOrder(12, 0, 5, 80, GoodTillCancel)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupPending)
+	group, _ := orderBook.GetOrderGroup(11)
+	if !group.IsPending(11) || group.IsPending(10) {
+		t.Fatal("only the children should be pending")
+	}
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(11, 0, 1, 130, GoodTillCancel))); err == nil {
+		t.Fatal("expected a pending child's id to be taken")
+	}
+
+	// A partial fill of the parent keeps the children back
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(20, 0, 3, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupPending)
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(21, 0, 2, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupActive)
+	if _, resting := orderBook.GetOrder(12); !resting {
+		t.Fatal("stop child did not enter the book")
+	}
+
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(22, 0, 10, 120, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupCompleted)
+	if _, resting := orderBook.GetOrder(12); resting {
+		t.Error("stop child still resting after the take profit traded")
+	}
+}
+
+func TestOneTriggersOtherParentCancelled(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	_, err := orderBook.AddOrderGroup(1, OneTriggersOther, []*Order{
+		order(LimitBidOrder(10, 0, 5, 50, GoodTillCancel)),
+		order(LimitAskOrder(11, 0, 5, 200, GoodTillCancel)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := orderBook.DelOrder(10); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 11, GroupCancelled)
+	group, _ := orderBook.GetOrderGroup(11)
+	if child, _ := group.GetOrder(11); child.GetOpenQuantity() != 0 {
+		t.Errorf("child has %d open after its parent was cancelled", child.GetOpenQuantity())
+	}
+}
+
+func TestFinishedGroupsAreForgotten(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+
+	// Completed but its child still rests, so it is not finished
+	if _, err := orderBook.AddOrderGroup(0, OneTriggersOther, []*Order{
+		order(LimitBidOrder(1, 0, 5, 100, GoodTillCancel)),
+		order(LimitAskOrder(2, 0, 5, 120, GoodTillCancel)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(3, 0, 5, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 1, GroupCompleted)
+
+	// Cancelling a leg of each one cancels the other and finishes the group
+	for groupId := uint64(1); groupId <= finishedGroupHistorySize+1; groupId++ {
+		legId := groupId * 10
+		if _, err := orderBook.AddOrderGroup(groupId, OneCancelsOther, []*Order{
+			order(LimitAskOrder(legId, 0, 5, 110, GoodTillCancel)),
+			order(LimitAskOrder(legId+1, 0, 5, 111, GoodTillCancel)),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := orderBook.DelOrder(legId); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, exists := orderBook.GetOrderGroup(10); exists {
+		t.Error("the oldest finished group is still remembered")
+	}
+	expectGroupState(t, orderBook, 20, GroupCancelled)
+	expectGroupState(t, orderBook, (finishedGroupHistorySize+1)*10+1, GroupCancelled)
+	expectGroupState(t, orderBook, 2, GroupCompleted)
+	if len(orderBook.groups) != finishedGroupHistorySize+1 || len(orderBook.orderGroups) != 2*(finishedGroupHistorySize+1) {
+		t.Errorf("expected %d groups remembered, got %d groups of %d orders", finishedGroupHistorySize+1, len(orderBook.groups), len(orderBook.orderGroups))
+	}
+
+	// The first group finishes once its child leaves the book
+	if err := orderBook.DelOrder(2); err != nil {
+		t.Fatal(err)
+	}
+	if group := orderBook.groups[0]; !group.finished || orderBook.finishedGroups[len(orderBook.finishedGroups)-1] != group {
+		t.Error("the group did not finish after its last order left the book")
+	}
+
+	// A forgotten group's id and order ids can be used again
+	if _, err := orderBook.AddOrderGroup(1, OneCancelsOther, []*Order{
+		order(LimitAskOrder(10, 0, 5, 110, GoodTillCancel)),
+		order(LimitAskOrder(11, 0, 5, 111, GoodTillCancel)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 10, GroupActive)
+}
+
+// Orders that have left their group free their ids, an order using one again
+// is not mistaken for the group's
+func TestGroupOrderIdsUsedAgain(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+
+	// A finished one cancels other group
+	if _, err := orderBook.AddOrderGroup(1, OneCancelsOther, []*Order{
+		order(LimitAskOrder(10, 0, 5, 110, GoodTillCancel)),
+		order(LimitAskOrder(11, 0, 5, 111, GoodTillCancel)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := orderBook.DelOrder(10); err != nil {
+		t.Fatal(err)
+	}
+	finished, _ := orderBook.GetOrderGroup(10)
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(10, 0, 7, 115, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := orderBook.ModifyOrder(10, 116, 7); err != nil {
+		t.Fatal(err)
+	}
+	if _, grouped := orderBook.GetOrderGroup(10); grouped {
+		t.Error("the resting order is reported as part of the finished group")
+	}
+	if err := orderBook.DelOrder(10); err != nil {
+		t.Fatal(err)
+	}
+	if leg, _ := finished.GetOrder(10); leg.GetPrice() != 110 || leg.GetQuantity() != 5 || finished.GetState() != GroupCancelled {
+		t.Errorf("the finished group took on the new order, its leg is %v", leg)
+	}
+
+	// A bracket whose filled parent's id is used while its children still rest
+	if _, err := orderBook.AddOrderGroup(2, Bracket, []*Order{
+		order(LimitBidOrder(20, 0, 5, 100, GoodTillCancel)),
+		order(LimitAskOrder(21, 0, 5, 120, GoodTillCancel)),
+		order(StopAskOrder(22, 0, 5, 80, GoodTillCancel)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(30, 0, 5, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 21, GroupActive)
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 5, 90, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	if err := orderBook.DelOrder(20); err != nil {
+		t.Fatal(err)
+	}
+	expectGroupState(t, orderBook, 21, GroupActive)
+	if _, resting := orderBook.GetOrder(22); !resting {
+		t.Fatal("deleting the new order touched the bracket")
+	}
+	// Its children still settle it, and with the new order gone it finishes
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 5, 95, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(31, 0, 10, 120, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	group, _ := orderBook.GetOrderGroup(21)
+	if group.GetState() != GroupCompleted || !group.finished {
+		t.Errorf("expected the bracket to complete and finish while order 20 rests, got %v", group.GetState())
+	}
+}
@@ -0,0 +1,68 @@
+package orderbook
+
+// OrderView is a copy of a resting order's public state, used for market by
+// order (L3) views of the book
+type OrderView struct {
+	orderId  uint64
+	side     Side
+	price    uint64
+	quantity uint64
+	// Position inside the level, zero is the front of the queue
+	queuePosition int
+}
+
+func (view *OrderView) GetOrderId() uint64 {
+	return view.orderId
+}
+
+func (view *OrderView) GetSide() Side {
+	return view.side
+}
+
+func (view *OrderView) GetPrice() uint64 {
+	return view.price
+}
+
+func (view *OrderView) GetQuantity() uint64 {
+	return view.quantity
+}
+
+func (view *OrderView) GetQueuePosition() int {
+	return view.queuePosition
+}
+
+// Orders of the level in time priority
+func (level *Level) GetOrderViews() []OrderView {
+	views := make([]OrderView, 0, level.orders.Len())
+	position := 0
+	for orderElem := level.orders.Front(); orderElem != nil; orderElem = orderElem.Next() {
+		order := orderElem.Value.(*Order)
+		views = append(views, OrderView{
+			orderId:       order.id,
+			side:          order.orderSide,
+			price:         level.price,
+			quantity:      order.GetVisibleQuantity(),
+			queuePosition: position,
+		})
+		position++
+	}
+	return views
+}
+
+// Every resting order of the top n bid levels, best price first and in time
+// priority within each level
+func (orderBook *OrderBook) GetTopNBidOrders(n int) []OrderView {
+	views := []OrderView{}
+	for _, level := range orderBook.GetTopNBids(n) {
+		views = append(views, level.GetOrderViews()...)
+	}
+	return views
+}
+
+func (orderBook *OrderBook) GetTopNAskOrders(n int) []OrderView {
+	views := []OrderView{}
+	for _, level := range orderBook.GetTopNAsks(n) {
+		views = append(views, level.GetOrderViews()...)
+	}
+	return views
+}
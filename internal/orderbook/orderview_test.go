@@ -0,0 +1,61 @@
+package orderbook
+
+import (
+	"testing"
+)
+
+func TestOrderViews(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	for _, newOrder := range []*Order{
+		order(LimitBidOrder(1, 0, 10, 100, GoodTillCancel)),
+		order(LimitBidOrder(2, 0, 20, 100, GoodTillCancel)),
+		order(LimitBidOrder(3, 0, 30, 99, GoodTillCancel)),
+		order(LimitBidOrder(4, 0, 40, 100, GoodTillCancel)),
+		order(LimitAskOrder(5, 0, 5, 101, GoodTillCancel)),
+		// Fills 4 of order 1, which keeps its place
+		order(LimitAskOrder(6, 0, 4, 100, GoodTillCancel)),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := orderBook.DelOrder(2); err != nil {
+		t.Fatal(err)
+	}
+
+	type view struct {
+		orderId, price, quantity uint64
+		queuePosition            int
+	}
+	expectViews := func(side string, views []OrderView, want []view) {
+		t.Helper()
+		if len(views) != len(want) {
+			t.Fatalf("expected %d %s orders, got %v", len(want), side, views)
+		}
+		for i := range views {
+			got := view{views[i].GetOrderId(), views[i].GetPrice(), views[i].GetQuantity(), views[i].GetQueuePosition()}
+			if got != want[i] {
+				t.Errorf("%s order %d: expected %v, got %v", side, i, want[i], got)
+			}
+		}
+	}
+	// Best level first, queue positions count from the front of each level
+	expectViews("bid", orderBook.GetTopNBidOrders(10), []view{
+		{1, 100, 6, 0},
+		{4, 100, 40, 1},
+		{3, 99, 30, 0},
+	})
+	expectViews("bid", orderBook.GetTopNBidOrders(1), []view{
+		{1, 100, 6, 0},
+		{4, 100, 40, 1},
+	})
+	expectViews("ask", orderBook.GetTopNAskOrders(10), []view{
+		{5, 101, 5, 0},
+	})
+	for _, view := range orderBook.GetTopNBidOrders(10) {
+		if view.GetSide() != Bid {
+			t.Errorf("order %d shown on the wrong side", view.GetOrderId())
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package orderbook
+
+import "math"
+
+// Best bid and ask of the orders that are not pegged, pegs never follow each
+// other so repricing one cannot move another
+type pegReferences struct {
+	bid    uint64
+	ask    uint64
+	hasBid bool
+	hasAsk bool
+}
+
+func (orderBook *OrderBook) currentPegReferences() pegReferences {
+	references := pegReferences{}
+	orderBook.bidLevels.SetMapEnd()
+	bidLevelsIt := orderBook.bidLevels.levelMapIterator
+	for bidLevelsIt.Prev() {
+		if level := bidLevelsIt.Value().(*Level); hasUnpeggedOrder(level) {
+			references.bid, references.hasBid = level.price, true
+			break
+		}
+	}
+	orderBook.askLevels.SetMapBegin()
+	askLevelsIt := orderBook.askLevels.levelMapIterator
+	for askLevelsIt.Next() {
+		if level := askLevelsIt.Value().(*Level); hasUnpeggedOrder(level) {
+			references.ask, references.hasAsk = level.price, true
+			break
+		}
+	}
+	return references
+}
+
+func hasUnpeggedOrder(level *Level) bool {
+	for e := level.orders.Front(); e != nil; e = e.Next() {
+		if !e.Value.(*Order).IsPegged() {
+			return true
+		}
+	}
+	return false
+}
+
+// Works out where a pegged order should rest, false if the price it follows
+// is missing or the offset takes it out of range
+func (orderBook *OrderBook) pegPrice(order *Order, references pegReferences) (uint64, bool) {
+	var price uint64
+	switch {
+	case order.pegType == MidpointPeg:
+		if !references.hasBid || !references.hasAsk {
+			return 0, false
+		}
+		// Bids round down and asks round up to the nearest half tick
+		halfTick := max(orderBook.tickSize/2, 1)
+		sum := references.bid + references.ask
+		price = sum / (2 * halfTick) * halfTick
+		if order.IsAsk() && sum%(2*halfTick) != 0 {
+			price += halfTick
+		}
+	case (order.pegType == PrimaryPeg) == order.IsBid():
+		if !references.hasBid {
+			return 0, false
+		}
+		price = references.bid
+	default:
+		if !references.hasAsk {
+			return 0, false
+		}
+		price = references.ask
+	}
+
+	offset := uint64(order.pegOffset)
+	if order.pegOffset < 0 {
+		offset = uint64(-order.pegOffset)
+	}
+	if offset > math.MaxUint64/orderBook.tickSize {
+		return 0, false
+	}
+	offset *= orderBook.tickSize
+	if order.pegOffset < 0 {
+		if offset >= price {
+			return 0, false
+		}
+		return price - offset, true
+	}
+	if offset > math.MaxUint64-price {
+		return 0, false
+	}
+	return price + offset, true
+}
+
+func (orderBook *OrderBook) setPegPrice(order *Order) bool {
+	price, priced := orderBook.pegPrice(order, orderBook.currentPegReferences())
+	if priced {
+		order.price = price
+	}
+	return priced
+}
+
+// Reprices resting pegged orders once the prices they follow have moved.
+// A moved peg loses its time priority and may trade at its new price. Returns
+// true if any order moved.
+func (orderBook *OrderBook) UpdatePeggedOrders() bool {
+	references := orderBook.currentPegReferences()
+	if references == orderBook.pegReferences {
+		return false
+	}
+	orderBook.pegReferences = references
+
+	moved := false
+	for _, order := range orderBook.restingPeggedOrders() {
+		// Earlier pegs in this pass may have traded with it
+		if resting, exists := orderBook.orders[order.id]; !exists || resting != order {
+			continue
+		}
+		// Pegs with nothing to follow keep their last price
+		price, priced := orderBook.pegPrice(order, references)
+		if !priced || price == order.price {
+			continue
+		}
+		orderBook.movePeggedOrder(order, price)
+		moved = true
+	}
+	return moved
+}
+
+// Pegged orders in time priority within each side, bids first
+func (orderBook *OrderBook) restingPeggedOrders() []*Order {
+	pegged := []*Order{}
+	for _, levels := range []*LevelMap{orderBook.bidLevels, orderBook.askLevels} {
+		levels.SetMapBegin()
+		levelsIt := levels.levelMapIterator
+		for levelsIt.Next() {
+			for e := levelsIt.Value().(*Level).orders.Front(); e != nil; e = e.Next() {
+				if order := e.Value.(*Order); order.IsPegged() {
+					pegged = append(pegged, order)
+				}
+			}
+		}
+	}
+	return pegged
+}
+
+// The order is reported on the level it leaves and again on the one it joins
+func (orderBook *OrderBook) movePeggedOrder(order *Order, price uint64) {
+	level, err := orderBook.unlinkOrder(order)
+	if err != nil {
+		panic(err)
+	}
+	delete(orderBook.orders, order.id)
+	order.levelPtr = nil
+	orderBook.onOrderUpdated(order, level)
+
+	order.price = price
+	orderBook.Match(order)
+	if order.IsFilled() {
+		orderBook.cancelRemainder(order)
+		return
+	}
+	orderBook.InsertLimitOrder(order)
+	orderBook.onOrderUpdated(order, order.levelPtr)
+}
@@ -0,0 +1,105 @@
+package orderbook
+
+import "testing"
+
+func addPeggedOrder(t *testing.T, orderBook *OrderBook, order *Order, pegType PegType, pegOffset int64) *Order {
+	t.Helper()
+	order.SetPeg(pegType, pegOffset)
+	if _, err := orderBook.AddOrder(order); err != nil {
+		t.Fatal(err)
+	}
+	return order
+}
+
+func TestPeggedOrdersFollowTheBook(t *testing.T) {
+	order := mustOrder(t)
+	// Trade at 100, 5 asked at 101 and 5 bid at 99
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	primary := addPeggedOrder(t, orderBook, order(LimitBidOrder(10, 0, 5, 0, GoodTillCancel)), PrimaryPeg, -1)
+	market := addPeggedOrder(t, orderBook, order(LimitAskOrder(11, 0, 5, 0, GoodTillCancel)), MarketPeg, 3)
+	if primary.GetPrice() != 98 || market.GetPrice() != 102 {
+		t.Fatalf("pegged at %d and %d, want 98 and 102", primary.GetPrice(), market.GetPrice())
+	}
+
+	// A better bid moves both, pegs do not follow each other
+	if _, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 1, 100, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	if primary.GetPrice() != 99 || market.GetPrice() != 103 {
+		t.Fatalf("pegged at %d and %d, want 99 and 103", primary.GetPrice(), market.GetPrice())
+	}
+	if orderBook.GetBestAsk().GetPrice() != 101 {
+		t.Fatalf("best ask %d, want 101", orderBook.GetBestAsk().GetPrice())
+	}
+
+	// Once its reference is gone a peg keeps its last price
+	if err := orderBook.DelOrder(20); err != nil {
+		t.Fatal(err)
+	}
+	if err := orderBook.DelOrder(4); err != nil {
+		t.Fatal(err)
+	}
+	if primary.GetPrice() != 98 {
+		t.Fatalf("primary peg moved to %d without a reference", primary.GetPrice())
+	}
+}
+
+func TestMidpointPegs(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	orderBook.SetTickSize(2)
+	for _, newOrder := range []*Order{
+		order(LimitBidOrder(1, 0, 5, 100, GoodTillCancel)),
+		order(LimitAskOrder(2, 0, 5, 110, GoodTillCancel)),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Ticks are 2 apart, 105 sits half way between the 104 and 106 ticks
+	bid := addPeggedOrder(t, orderBook, order(LimitBidOrder(10, 0, 5, 0, GoodTillCancel)), MidpointPeg, 0)
+	if bid.GetPrice() != 105 {
+		t.Fatalf("midpoint bid at %d, want 105", bid.GetPrice())
+	}
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(3, 0, 5, 108, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	if bid.GetPrice() != 104 {
+		t.Fatalf("midpoint bid at %d, want 104", bid.GetPrice())
+	}
+
+	// Midpoint pegs on both sides meet at the midpoint and trade
+	ask := order(LimitAskOrder(11, 0, 3, 0, GoodTillCancel))
+	ask.SetPeg(MidpointPeg, 0)
+	trades, err := orderBook.AddOrder(ask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].GetPrice() != 104 || trades[0].GetQuantity() != 3 {
+		t.Fatalf("expected 3 traded at 104, got %v", trades)
+	}
+	if bid.GetOpenQuantity() != 2 {
+		t.Errorf("midpoint bid has %d open, want 2", bid.GetOpenQuantity())
+	}
+}
+
+func TestPegValidation(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	peg := order(LimitBidOrder(1, 0, 5, 0, GoodTillCancel))
+	peg.SetPeg(PrimaryPeg, 0)
+	if _, err := orderBook.AddOrder(peg); err == nil {
+		t.Error("expected a peg with nothing to follow to be rejected")
+	}
+	ioc := order(LimitBidOrder(2, 0, 5, 0, ImmediateOrCancel))
+	ioc.SetPeg(MidpointPeg, 0)
+	if _, err := orderBook.AddOrder(ioc); err == nil {
+		t.Error("expected an IOC peg to be rejected")
+	}
+	stop := order(StopBidOrder(3, 0, 5, 100, GoodTillCancel))
+	stop.SetPeg(MarketPeg, 0)
+	if _, err := orderBook.AddOrder(stop); err == nil {
+		t.Error("expected a pegged stop to be rejected")
+	}
+}
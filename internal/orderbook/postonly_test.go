@@ -0,0 +1,85 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPostOnlyOrders(t *testing.T) {
+	order := mustOrder(t)
+	postOnly := func(newOrder *Order, mode PostOnly) *Order {
+		newOrder.SetPostOnly(mode)
+		return newOrder
+	}
+	// A tick of 5 with 5 asked at 105 and 5 bid at 95
+	newOrderBook := func() *OrderBook {
+		orderBook := NewOrderbook(0)
+		orderBook.SetTickSize(5)
+		for _, newOrder := range []*Order{
+			order(LimitAskOrder(1, 0, 5, 105, GoodTillCancel)),
+			order(LimitBidOrder(2, 0, 5, 95, GoodTillCancel)),
+		} {
+			if _, err := orderBook.AddOrder(newOrder); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return orderBook
+	}
+
+	for _, test := range []struct {
+		name  string
+		order *Order
+		err   error
+		// Price the order rests at, zero if it must not rest
+		price uint64
+	}{
+		{"passive bid", postOnly(order(LimitBidOrder(3, 0, 5, 100, GoodTillCancel)), PostOnlyReject), nil, 100},
+		{"passive ask", postOnly(order(LimitAskOrder(3, 0, 5, 100, GoodTillCancel)), PostOnlyReject), nil, 100},
+		{"crossing bid", postOnly(order(LimitBidOrder(3, 0, 5, 105, GoodTillCancel)), PostOnlyReject), ErrPostOnlyWouldCross, 0},
+		{"crossing ask", postOnly(order(LimitAskOrder(3, 0, 5, 90, GoodTillCancel)), PostOnlyReject), ErrPostOnlyWouldCross, 0},
+		{"repriced bid", postOnly(order(LimitBidOrder(3, 0, 5, 120, GoodTillCancel)), PostOnlyReprice), nil, 100},
+		{"repriced ask", postOnly(order(LimitAskOrder(3, 0, 5, 90, GoodTillCancel)), PostOnlyReprice), nil, 100},
+		{"immediate or cancel", postOnly(order(LimitBidOrder(3, 0, 5, 100, ImmediateOrCancel)), PostOnlyReject), ErrInvalidPostOnly, 0},
+		{"market", postOnly(order(MarketBidOrder(3, 0, 5, ImmediateOrCancel)), PostOnlyReprice), ErrInvalidPostOnly, 0},
+	} {
+		orderBook := newOrderBook()
+		trades, err := orderBook.AddOrder(test.order)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if len(trades) != 0 {
+			t.Errorf("%s: post only order traded %v", test.name, trades)
+		}
+		resting, exists := orderBook.GetOrder(3)
+		if exists != (test.price != 0) || exists && resting.GetPrice() != test.price {
+			t.Errorf("%s: expected the order resting at %d, resting %v", test.name, test.price, exists)
+		}
+	}
+
+	// No bid price is left a tick below an ask at the tick itself
+	orderBook := NewOrderbook(0)
+	orderBook.SetTickSize(5)
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(1, 0, 5, 5, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	bid := postOnly(order(LimitBidOrder(2, 0, 5, 10, GoodTillCancel)), PostOnlyReprice)
+	if trades, err := orderBook.AddOrder(bid); err != nil || len(trades) != 0 {
+		t.Fatalf("expected the bid to be cancelled quietly, got %v and %v", trades, err)
+	}
+	if _, exists := orderBook.GetOrder(2); exists || bid.GetOpenQuantity() != 0 {
+		t.Errorf("expected the bid to be cancelled rather than priced at %d", bid.GetPrice())
+	}
+
+	// Amends are held to the same rule
+	orderBook = newOrderBook()
+	if _, err := orderBook.AddOrder(postOnly(order(LimitBidOrder(3, 0, 5, 90, GoodTillCancel)), PostOnlyReject)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := orderBook.ReplaceOrder(3, 4, 105); !errors.Is(err, ErrPostOnlyWouldCross) {
+		t.Errorf("expected a crossing replace to be refused, got %v", err)
+	}
+	if resting, exists := orderBook.GetOrder(3); !exists || resting.GetPrice() != 90 {
+		t.Error("the refused replace lost the original order")
+	}
+}
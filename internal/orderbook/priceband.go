@@ -0,0 +1,147 @@
+package orderbook
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Band widths are given in basis points of the price they are centred on
+const basisPoints = 10000
+
+// PriceBands limit the prices a book trades at. The static band is centred on
+// a reference price, such as the previous close, and orders priced outside it
+// are rejected. The dynamic collar is centred on the last executed price when
+// an order starts matching, a trade outside it halts matching and starts a
+// volatility auction instead. A zero width turns a band off.
+type PriceBands struct {
+	referencePrice uint64
+	staticBand     uint64
+	dynamicCollar  uint64
+}
+
+func NewPriceBands(_referencePrice uint64, _staticBand uint64, _dynamicCollar uint64) PriceBands {
+	return PriceBands{
+		referencePrice: _referencePrice,
+		staticBand:     _staticBand,
+		dynamicCollar:  _dynamicCollar,
+	}
+}
+
+func (bands PriceBands) GetReferencePrice() uint64 {
+	return bands.referencePrice
+}
+
+func (bands PriceBands) GetStaticBand() uint64 {
+	return bands.staticBand
+}
+
+func (bands PriceBands) GetDynamicCollar() uint64 {
+	return bands.dynamicCollar
+}
+
+func (orderBook *OrderBook) GetPriceBands() PriceBands {
+	return orderBook.priceBands
+}
+
+// Orders already resting keep their price, they only stop trading once
+// outside the new bands
+func (orderBook *OrderBook) SetPriceBands(priceBands PriceBands) {
+	orderBook.priceBands = priceBands
+}
+
+// True while an auction started by a trade outside the dynamic collar runs
+func (orderBook *OrderBook) IsInVolatilityAuction() bool {
+	return orderBook.volatilityAuction
+}
+
+// Prices within width basis points of price, clamped to the uint64 range
+func bandLimits(price uint64, width uint64) (uint64, uint64) {
+	var offset uint64 = math.MaxUint64
+	if hi, lo := bits.Mul64(price, width); hi < basisPoints {
+		offset, _ = bits.Div64(hi, lo, basisPoints)
+	}
+	var low, high uint64 = 0, math.MaxUint64
+	if offset < price {
+		low = price - offset
+	}
+	if offset < math.MaxUint64-price {
+		high = price + offset
+	}
+	return low, high
+}
+
+// The whole price range when the static band is off
+func (orderBook *OrderBook) staticBandLimits() (uint64, uint64) {
+	bands := orderBook.priceBands
+	if bands.staticBand == 0 || bands.referencePrice == 0 {
+		return 0, math.MaxUint64
+	}
+	return bandLimits(bands.referencePrice, bands.staticBand)
+}
+
+// The last executed price, or the bands' reference price for a book that has
+// not traded yet
+func (orderBook *OrderBook) referencePrice() (uint64, bool) {
+	if orderBook.lastExecutedPrice != 0 {
+		return orderBook.lastExecutedPrice, true
+	}
+	reference := orderBook.priceBands.referencePrice
+	return reference, reference != 0
+}
+
+// Books that have not traded yet are collared around the reference price
+func (orderBook *OrderBook) dynamicCollarLimits() (uint64, uint64) {
+	centre, exists := orderBook.referencePrice()
+	if orderBook.priceBands.dynamicCollar == 0 || !exists {
+		return 0, math.MaxUint64
+	}
+	return bandLimits(centre, orderBook.priceBands.dynamicCollar)
+}
+
+// Limit prices are checked on entry, pegs only get a price once they rest
+func (orderBook *OrderBook) checkStaticBand(order *Order) error {
+	if order.IsPegged() || !(order.IsLimit() || order.IsStopLimit() || order.IsTrailingStopLimit()) {
+		return nil
+	}
+	low, high := orderBook.staticBandLimits()
+	if order.price < low || order.price > high {
+		return newOrderError(order.id, ErrPriceOutsideBand)
+	}
+	return nil
+}
+
+// Where one order may trade, worked out once before it starts matching so a
+// sweep cannot walk the collar along with it
+type tradingLimits struct {
+	staticLow  uint64
+	staticHigh uint64
+	collarLow  uint64
+	collarHigh uint64
+}
+
+func (orderBook *OrderBook) currentTradingLimits() tradingLimits {
+	limits := tradingLimits{}
+	limits.staticLow, limits.staticHigh = orderBook.staticBandLimits()
+	limits.collarLow, limits.collarHigh = orderBook.dynamicCollarLimits()
+	return limits
+}
+
+func (limits tradingLimits) allows(price uint64) bool {
+	return price >= limits.staticLow && price <= limits.staticHigh &&
+		price >= limits.collarLow && price <= limits.collarHigh
+}
+
+// Returns false if matching has to stop before trading at price. Prices
+// outside the collar start a volatility auction, the static band only stops
+// orders that rested before it was moved.
+func (orderBook *OrderBook) checkTradePrice(limits tradingLimits, price uint64) bool {
+	if price < limits.staticLow || price > limits.staticHigh {
+		return false
+	}
+	if price < limits.collarLow || price > limits.collarHigh {
+		orderBook.inAuction = true
+		orderBook.volatilityAuction = true
+		return false
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStaticPriceBand(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	// 10% either side of 100
+	orderBook.SetPriceBands(NewPriceBands(100, 1000, 0))
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(1, 0, 5, 111, GoodTillCancel))); !errors.Is(err, ErrPriceOutsideBand) {
+		t.Fatalf("expected ErrPriceOutsideBand, got %v", err)
+	}
+	for _, newOrder := range []*Order{
+		order(LimitAskOrder(2, 0, 5, 105, GoodTillCancel)),
+		order(LimitAskOrder(3, 0, 5, 110, GoodTillCancel)),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Narrowing the band leaves 110 resting out of reach of a market order
+	orderBook.SetPriceBands(NewPriceBands(100, 500, 0))
+	trades, err := orderBook.AddOrder(order(MarketBidOrder(4, 0, 10, ImmediateOrCancel)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].GetPrice() != 105 {
+		t.Fatalf("expected one trade at 105, got %v", trades)
+	}
+	if _, resting := orderBook.GetOrder(3); !resting {
+		t.Error("the ask outside the band traded")
+	}
+}
+
+func TestDynamicCollarStartsVolatilityAuction(t *testing.T) {
+	order := mustOrder(t)
+	// Trade at 100, 5 asked at 101 and 5 bid at 99
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	// 5% either side of the last trade, 95 to 105
+	orderBook.SetPriceBands(NewPriceBands(100, 0, 500))
+	for _, newOrder := range []*Order{
+		order(LimitAskOrder(10, 0, 5, 104, GoodTillCancel)),
+		order(LimitAskOrder(11, 0, 5, 120, GoodTillCancel)),
+		order(StopBidOrder(12, 0, 1, 104, GoodTillCancel)),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The sweep stops at 120 rather than walking the collar up with each trade
+	trades, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 20, 130, GoodTillCancel)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 2 || trades[1].GetPrice() != 104 {
+		t.Fatalf("expected trades at 101 and 104, got %v", trades)
+	}
+	if !orderBook.IsInVolatilityAuction() {
+		t.Fatal("trading outside the collar did not start a volatility auction")
+	}
+	if _, resting := orderBook.GetOrder(12); !resting {
+		t.Fatal("the stop triggered during the auction")
+	}
+
+	// 10 bid at 130 against 5 asked at 120
+	if _, err := orderBook.Uncross(); err != nil {
+		t.Fatal(err)
+	}
+	if orderBook.IsInVolatilityAuction() || orderBook.LastExecutedPriceBid() != 120 {
+		t.Fatalf("expected the uncross at 120 to end the auction, last price %d", orderBook.LastExecutedPriceBid())
+	}
+	if _, resting := orderBook.GetOrder(12); resting {
+		t.Error("the stop was not triggered by the uncross")
+	}
+}
+
+// Fill or kill orders are killed instead of halting the book
+func TestFillOrKillOutsideCollar(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	orderBook.SetPriceBands(NewPriceBands(100, 0, 500))
+	if _, err := orderBook.AddOrder(order(LimitAskOrder(10, 0, 5, 120, GoodTillCancel))); err != nil {
+		t.Fatal(err)
+	}
+	trades, err := orderBook.AddOrder(order(LimitBidOrder(20, 0, 10, 120, FillOrKill)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 0 || orderBook.IsInAuction() {
+		t.Fatalf("expected the order to be killed, got %v", trades)
+	}
+}
+
+func TestReplaceOutsideStaticBand(t *testing.T) {
+	orderBook := newMatrixOrderBook(t, NullEventHandler{})
+	orderBook.SetPriceBands(NewPriceBands(100, 1000, 0))
+	if _, _, err := orderBook.ReplaceOrder(3, 3, 120); !errors.Is(err, ErrPriceOutsideBand) {
+		t.Fatalf("expected ErrPriceOutsideBand, got %v", err)
+	}
+	if _, resting := orderBook.GetOrder(3); !resting {
+		t.Error("the rejected replace took the original order out of the book")
+	}
+}
@@ -0,0 +1,170 @@
+package orderbook
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ReferenceData describes how a symbol may be traded. Prices are whole
+// numbers of the smallest price unit, priceScale is the number of decimal
+// places they are shown with. A zero maximum means there is none.
+type ReferenceData struct {
+	tickSize    uint64
+	lotSize     uint64
+	minQuantity uint64
+	maxQuantity uint64
+	// Largest price times quantity of a single order
+	maxNotional uint64
+	priceScale  uint32
+}
+
+// Reference data that accepts every order, used by symbols added without any
+func DefaultReferenceData() ReferenceData {
+	return ReferenceData{tickSize: 1, lotSize: 1}
+}
+
+// A zero tick or lot size is taken as 1
+func NewReferenceData(_tickSize uint64, _lotSize uint64, _minQuantity uint64, _maxQuantity uint64, _maxNotional uint64, _priceScale uint32) (ReferenceData, error) {
+	referenceData := ReferenceData{
+		tickSize:    max(_tickSize, 1),
+		lotSize:     max(_lotSize, 1),
+		minQuantity: _minQuantity,
+		maxQuantity: _maxQuantity,
+		maxNotional: _maxNotional,
+		priceScale:  _priceScale,
+	}
+	if referenceData.maxQuantity > 0 && referenceData.minQuantity > referenceData.maxQuantity {
+		return ReferenceData{}, ErrInvalidReferenceData
+	}
+	// Prices are shown from a uint64, which has at most 20 digits
+	if referenceData.priceScale > 19 {
+		return ReferenceData{}, ErrInvalidReferenceData
+	}
+	return referenceData, nil
+}
+
+func (referenceData ReferenceData) GetTickSize() uint64 {
+	return referenceData.tickSize
+}
+
+func (referenceData ReferenceData) GetLotSize() uint64 {
+	return referenceData.lotSize
+}
+
+func (referenceData ReferenceData) GetMinQuantity() uint64 {
+	return referenceData.minQuantity
+}
+
+func (referenceData ReferenceData) GetMaxQuantity() uint64 {
+	return referenceData.maxQuantity
+}
+
+func (referenceData ReferenceData) GetMaxNotional() uint64 {
+	return referenceData.maxNotional
+}
+
+func (referenceData ReferenceData) GetPriceScale() uint32 {
+	return referenceData.priceScale
+}
+
+func (referenceData ReferenceData) CheckPrice(price uint64) error {
+	if price%referenceData.tickSize != 0 {
+		return ErrPriceNotOnTick
+	}
+	return nil
+}
+
+func (referenceData ReferenceData) CheckQuantity(quantity uint64) error {
+	switch {
+	case quantity%referenceData.lotSize != 0:
+		return ErrQuantityNotInLots
+	case quantity < referenceData.minQuantity:
+		return ErrQuantityBelowMinimum
+	case referenceData.maxQuantity > 0 && quantity > referenceData.maxQuantity:
+		return ErrQuantityAboveMaximum
+	}
+	return nil
+}
+
+func (referenceData ReferenceData) CheckNotional(price uint64, quantity uint64) error {
+	if referenceData.maxNotional == 0 {
+		return nil
+	}
+	if hi, lo := bits.Mul64(price, quantity); hi > 0 || lo > referenceData.maxNotional {
+		return ErrNotionalAboveMaximum
+	}
+	return nil
+}
+
+// CheckOrder validates a new order against the reference data. Limit prices
+// are used for the notional, stops use their stop price. Pegged, market and
+// trailing stop orders have no fixed price, their notional is taken at
+// marketPrice instead and they are rejected when it is zero.
+func (referenceData ReferenceData) CheckOrder(order *Order, marketPrice uint64) error {
+	hasLimitPrice := order.IsLimit() && !order.IsPegged() || order.IsStopLimit() || order.IsTrailingStopLimit()
+	prices := []uint64{}
+	if hasLimitPrice {
+		prices = append(prices, order.price)
+	}
+	if order.IsStop() || order.IsStopLimit() {
+		prices = append(prices, order.stopPrice)
+	}
+	if order.IsTrailingStop() || order.IsTrailingStopLimit() {
+		prices = append(prices, order.trailingAmount)
+	}
+	for _, price := range prices {
+		if err := referenceData.CheckPrice(price); err != nil {
+			return newOrderError(order.id, err)
+		}
+	}
+	if err := referenceData.CheckQuantity(order.quantity); err != nil {
+		return newOrderError(order.id, err)
+	}
+	if order.displayQuantity%referenceData.lotSize != 0 {
+		return newOrderError(order.id, ErrQuantityNotInLots)
+	}
+	notionalPrice := marketPrice
+	if hasLimitPrice {
+		notionalPrice = order.price
+	} else if order.IsStop() {
+		notionalPrice = order.stopPrice
+	} else if marketPrice == 0 && referenceData.maxNotional != 0 {
+		return newOrderError(order.id, ErrNoMarketPrice)
+	}
+	if err := referenceData.CheckNotional(notionalPrice, order.quantity); err != nil {
+		return newOrderError(order.id, err)
+	}
+	return nil
+}
+
+// GetMarketPrice is the price an order on side without a fixed price is
+// valued at: the far touch it would trade against, or the reference price
+// when that side of the book is empty. Zero when there is neither.
+func (orderBook *OrderBook) GetMarketPrice(side Side) uint64 {
+	if side == Bid && !orderBook.askLevels.IsEmpty() {
+		return orderBook.GetBestAsk().price
+	}
+	if side == Ask && !orderBook.bidLevels.IsEmpty() {
+		return orderBook.GetBestBid().price
+	}
+	reference, _ := orderBook.referencePrice()
+	return reference
+}
+
+// FormatPrice shows price with priceScale decimal places
+func FormatPrice(price uint64, priceScale uint32) string {
+	digits := strconv.FormatUint(price, 10)
+	if priceScale == 0 {
+		return digits
+	}
+	scale := int(priceScale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+func (referenceData ReferenceData) FormatPrice(price uint64) string {
+	return FormatPrice(price, referenceData.priceScale)
+}
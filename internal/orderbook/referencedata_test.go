@@ -0,0 +1,57 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReferenceDataCheckOrder(t *testing.T) {
+	order := mustOrder(t)
+	// Ticks of 5, lots of 10, 10 to 1000 per order and at most 50000 notional
+	referenceData, err := NewReferenceData(5, 10, 10, 1000, 50000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		order       *Order
+		marketPrice uint64
+		want        error
+	}{
+		{"on tick and in lots", order(LimitBidOrder(1, 0, 100, 500, GoodTillCancel)), 0, nil},
+		{"price off tick", order(LimitBidOrder(2, 0, 100, 502, GoodTillCancel)), 0, ErrPriceNotOnTick},
+		{"stop price off tick", order(StopLimitAskOrder(3, 0, 100, 500, 497, GoodTillCancel)), 0, ErrPriceNotOnTick},
+		{"odd lot", order(LimitBidOrder(4, 0, 105, 100, GoodTillCancel)), 0, ErrQuantityNotInLots},
+		{"above maximum", order(LimitBidOrder(5, 0, 1010, 5, GoodTillCancel)), 0, ErrQuantityAboveMaximum},
+		{"notional above maximum", order(LimitBidOrder(6, 0, 110, 500, GoodTillCancel)), 0, ErrNotionalAboveMaximum},
+		{"market notional at the market price", order(MarketBidOrder(7, 0, 200, ImmediateOrCancel)), 300, ErrNotionalAboveMaximum},
+		{"market without a market price", order(MarketBidOrder(8, 0, 200, ImmediateOrCancel)), 0, ErrNoMarketPrice},
+	}
+	for _, test := range tests {
+		if err := referenceData.CheckOrder(test.order, test.marketPrice); !errors.Is(err, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.want)
+		}
+	}
+
+	if _, err := NewReferenceData(1, 1, 100, 10, 0, 0); !errors.Is(err, ErrInvalidReferenceData) {
+		t.Errorf("expected a minimum above the maximum to be rejected, got %v", err)
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	for _, test := range []struct {
+		price uint64
+		scale uint32
+		want  string
+	}{
+		{12345, 0, "12345"},
+		{12345, 2, "123.45"},
+		{5, 2, "0.05"},
+		{100, 2, "1.00"},
+		{0, 3, "0.000"},
+	} {
+		if got := FormatPrice(test.price, test.scale); got != test.want {
+			t.Errorf("%d at scale %d: got %s, want %s", test.price, test.scale, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package orderbook
+
+import (
+	"testing"
+)
+
+func TestSelfTradePrevention(t *testing.T) {
+	order := mustOrder(t)
+	participantOrder := func(newOrder *Order, participantId uint64, mode SelfTradePrevention) *Order {
+		newOrder.SetParticipantId(participantId)
+		newOrder.SetSelfTradePrevention(mode)
+		return newOrder
+	}
+
+	for _, test := range []struct {
+		name      string
+		aggressor *Order
+		// Resting orders the aggressor traded with, in order
+		tradedWith []uint64
+		// Left in the book of participant 7's resting order, left of the
+		// aggressor and whether the aggressor rests
+		restingOpen, aggressorOpen uint64
+		aggressorRests             bool
+	}{
+		{"no prevention", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 7, NoSelfTradePrevention), []uint64{1, 2}, 0, 0, false},
+		{"other participant", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 9, CancelBoth), []uint64{1, 2}, 0, 0, false},
+		{"no participant", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 0, CancelBoth), []uint64{1, 2}, 0, 0, false},
+		// The aggressor carries on down the queue
+		{"cancel resting", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 7, CancelResting), []uint64{2}, 0, 5, true},
+		{"cancel aggressor", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 7, CancelAggressor), nil, 10, 0, false},
+		{"cancel both", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 7, CancelBoth), nil, 0, 0, false},
+		// The smaller of the two comes off both, the aggressor trades what is left
+		{"decrement smaller resting", participantOrder(order(LimitBidOrder(3, 0, 15, 100, GoodTillCancel)), 7, DecrementAndCancel), []uint64{2}, 0, 0, false},
+		{"decrement smaller aggressor", participantOrder(order(LimitBidOrder(3, 0, 4, 100, GoodTillCancel)), 7, DecrementAndCancel), nil, 6, 0, false},
+	} {
+		orderBook := NewOrderbook(0)
+		resting := participantOrder(order(LimitAskOrder(1, 0, 10, 100, GoodTillCancel)), 7, NoSelfTradePrevention)
+		for _, newOrder := range []*Order{resting, participantOrder(order(LimitAskOrder(2, 0, 10, 100, GoodTillCancel)), 8, NoSelfTradePrevention)} {
+			if _, err := orderBook.AddOrder(newOrder); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		trades, err := orderBook.AddOrder(test.aggressor)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(trades) != len(test.tradedWith) {
+			t.Fatalf("%s: expected trades with %v, got %v", test.name, test.tradedWith, trades)
+		}
+		for i := range trades {
+			if trades[i].GetAskOrderId() != test.tradedWith[i] {
+				t.Errorf("%s: trade %d was with order %d, want %d", test.name, i, trades[i].GetAskOrderId(), test.tradedWith[i])
+			}
+		}
+		var restingOpen uint64
+		if _, exists := orderBook.GetOrder(1); exists {
+			restingOpen = resting.GetOpenQuantity()
+		}
+		if restingOpen != test.restingOpen {
+			t.Errorf("%s: expected %d left of the resting order in the book, got %d", test.name, test.restingOpen, restingOpen)
+		}
+		if test.aggressor.GetOpenQuantity() != test.aggressorOpen {
+			t.Errorf("%s: expected %d left of the aggressor, got %d", test.name, test.aggressorOpen, test.aggressor.GetOpenQuantity())
+		}
+		if _, exists := orderBook.GetOrder(3); exists != test.aggressorRests {
+			t.Errorf("%s: expected the aggressor resting to be %v", test.name, test.aggressorRests)
+		}
+	}
+
+	// Fill or kill only counts what it could trade without trading with itself
+	orderBook := NewOrderbook(0)
+	for _, newOrder := range []*Order{
+		participantOrder(order(LimitAskOrder(1, 0, 10, 100, GoodTillCancel)), 7, NoSelfTradePrevention),
+		participantOrder(order(LimitAskOrder(2, 0, 10, 100, GoodTillCancel)), 8, NoSelfTradePrevention),
+	} {
+		if _, err := orderBook.AddOrder(newOrder); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fillOrKill := participantOrder(order(LimitBidOrder(3, 0, 15, 100, FillOrKill)), 7, CancelResting)
+	if trades, err := orderBook.AddOrder(fillOrKill); err != nil || len(trades) != 0 {
+		t.Fatalf("expected the fill or kill to be killed, got %v and %v", trades, err)
+	}
+	if level := orderBook.GetBestAsk(); level.GetVolume() != 20 {
+		t.Errorf("the killed order changed the book, %d left at 100", level.GetVolume())
+	}
+}
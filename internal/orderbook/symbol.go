@@ -2,19 +2,113 @@ package orderbook
 
 import "strconv"
 
+// SessionState is the trading phase a symbol is in. Symbols trade
+// continuously until they are moved to another state.
+type SessionState int
+
+const (
+	Continuous SessionState = iota
+	PreOpen
+	OpeningAuction
+	Halted
+	ClosingAuction
+	Closed
+	// Started by a trade outside the dynamic price collar
+	VolatilityAuction
+)
+
+func (state SessionState) String() string {
+	switch state {
+	case Continuous:
+		return "Continuous"
+	case PreOpen:
+		return "PreOpen"
+	case OpeningAuction:
+		return "OpeningAuction"
+	case Halted:
+		return "Halted"
+	case ClosingAuction:
+		return "ClosingAuction"
+	case Closed:
+		return "Closed"
+	case VolatilityAuction:
+		return "VolatilityAuction"
+	default:
+		return "Unknown"
+	}
+}
+
+// States a symbol can move to from each state
+var sessionTransitions = map[SessionState][]SessionState{
+	Continuous:     {Halted, ClosingAuction, Closed, VolatilityAuction},
+	PreOpen:        {OpeningAuction, Continuous, Halted, Closed},
+	OpeningAuction: {Continuous, Halted, Closed},
+	Halted:         {OpeningAuction, Continuous, Closed},
+	ClosingAuction: {Halted, Closed},
+	Closed:         {PreOpen},
+	// Trading resumes once the auction has run for its period
+	VolatilityAuction: {Continuous, Halted, Closed},
+}
+
+// CanMoveTo reports whether the session may go from state to next
+func (state SessionState) CanMoveTo(next SessionState) bool {
+	for _, allowed := range sessionTransitions[state] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Orders rest without matching in every auction
+func (state SessionState) IsAuction() bool {
+	return state == OpeningAuction || state == ClosingAuction || state == VolatilityAuction
+}
+
 type Symbol struct {
-	symbolId uint64
-	ticker   string
+	symbolId      uint64
+	ticker        string
+	sessionState  SessionState
+	referenceData ReferenceData
 }
 
 func NewSymbol(_symbolId uint64, _ticker string) Symbol {
+	return NewSymbolWithReferenceData(_symbolId, _ticker, DefaultReferenceData())
+}
+
+func NewSymbolWithReferenceData(_symbolId uint64, _ticker string, _referenceData ReferenceData) Symbol {
 	return Symbol{
-		symbolId: _symbolId,
-		ticker:   _ticker,
+		symbolId:      _symbolId,
+		ticker:        _ticker,
+		referenceData: _referenceData,
 	}
 }
 
+func (symbol *Symbol) GetSymbolId() uint64 {
+	return symbol.symbolId
+}
+
+func (symbol *Symbol) GetTicker() string {
+	return symbol.ticker
+}
+
+func (symbol *Symbol) GetSessionState() SessionState {
+	return symbol.sessionState
+}
+
+func (symbol *Symbol) SetSessionState(sessionState SessionState) {
+	symbol.sessionState = sessionState
+}
+
+func (symbol *Symbol) GetReferenceData() ReferenceData {
+	return symbol.referenceData
+}
+
+func (symbol *Symbol) SetReferenceData(referenceData ReferenceData) {
+	symbol.referenceData = referenceData
+}
+
 func (symbol *Symbol) String() string {
-	symbolString := strconv.FormatUint(symbol.symbolId, 10) + " " + symbol.ticker + "\n"
+	symbolString := strconv.FormatUint(symbol.symbolId, 10) + " " + symbol.ticker + " " + symbol.sessionState.String() + "\n"
 	return symbolString
 }
@@ -0,0 +1,82 @@
+package orderbook
+
+import "fmt"
+
+// A single match between a bid and an ask order
+type Trade struct {
+	tradeId        uint64
+	symbolId       uint64
+	bidOrderId     uint64
+	askOrderId     uint64
+	aggressorSide  Side
+	price          uint64
+	quantity       uint64
+	sequenceNumber uint64
+	timestamp      int64
+}
+
+func (trade Trade) String() string {
+	return fmt.Sprintf("Trade ID: %d\nSymbol ID: %d\nBid Order ID: %d\nAsk Order ID: %d\nAggressor: %v\nPrice: %d\nQuantity: %d\nSequence Number: %d\nTimestamp: %d",
+		trade.tradeId,
+		trade.symbolId,
+		trade.bidOrderId,
+		trade.askOrderId,
+		trade.aggressorSide,
+		trade.price,
+		trade.quantity,
+		trade.sequenceNumber,
+		trade.timestamp,
+	)
+}
+
+func (t *Trade) GetTradeId() uint64 {
+	return t.tradeId
+}
+
+func (t *Trade) GetSymbolId() uint64 {
+	return t.symbolId
+}
+
+func (t *Trade) GetBidOrderId() uint64 {
+	return t.bidOrderId
+}
+
+func (t *Trade) GetAskOrderId() uint64 {
+	return t.askOrderId
+}
+
+func (t *Trade) GetAggressorSide() Side {
+	return t.aggressorSide
+}
+
+// Order id of the side that took liquidity
+func (t *Trade) GetAggressorOrderId() uint64 {
+	if t.aggressorSide == Bid {
+		return t.bidOrderId
+	}
+	return t.askOrderId
+}
+
+// Order id of the side that was resting in the book
+func (t *Trade) GetPassiveOrderId() uint64 {
+	if t.aggressorSide == Bid {
+		return t.askOrderId
+	}
+	return t.bidOrderId
+}
+
+func (t *Trade) GetPrice() uint64 {
+	return t.price
+}
+
+func (t *Trade) GetQuantity() uint64 {
+	return t.quantity
+}
+
+func (t *Trade) GetSequenceNumber() uint64 {
+	return t.sequenceNumber
+}
+
+func (t *Trade) GetTimestamp() int64 {
+	return t.timestamp
+}
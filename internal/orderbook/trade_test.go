@@ -0,0 +1,60 @@
+package orderbook
+
+import (
+	"testing"
+)
+
+func TestAddOrderReturnsTrades(t *testing.T) {
+	order := mustOrder(t)
+	orderBook := NewOrderbook(0)
+	addOrder := func(newOrder *Order) []Trade {
+		t.Helper()
+		trades, err := orderBook.AddOrder(newOrder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return trades
+	}
+
+	addOrder(order(LimitAskOrder(1, 0, 5, 100, GoodTillCancel)))
+	addOrder(order(LimitAskOrder(2, 0, 7, 101, GoodTillCancel)))
+	if trades := addOrder(order(LimitBidOrder(10, 0, 1, 100, GoodTillCancel))); len(trades) != 1 {
+		t.Fatalf("expected one trade, got %v", trades)
+	}
+	addOrder(order(StopBidOrder(3, 0, 2, 101, GoodTillCancel)))
+
+	// The sweep to 101 triggers the stop, whose trade is returned too
+	trades := addOrder(order(LimitBidOrder(4, 0, 9, 101, GoodTillCancel)))
+	want := []struct {
+		bidOrderId, askOrderId, price, quantity uint64
+	}{
+		{4, 1, 100, 4},
+		{4, 2, 101, 5},
+		{3, 2, 101, 2},
+	}
+	if len(trades) != len(want) {
+		t.Fatalf("expected %d trades, got %v", len(want), trades)
+	}
+	for i, trade := range trades {
+		if trade.GetBidOrderId() != want[i].bidOrderId || trade.GetAskOrderId() != want[i].askOrderId ||
+			trade.GetPrice() != want[i].price || trade.GetQuantity() != want[i].quantity {
+			t.Errorf("trade %d is %v, want %+v", i, trade, want[i])
+		}
+		if trade.GetTradeId() != uint64(i+2) || trade.GetAggressorSide() != Bid || trade.GetPassiveOrderId() != want[i].askOrderId {
+			t.Errorf("trade %d has id %d and aggressor %v", i, trade.GetTradeId(), trade.GetAggressorSide())
+		}
+		if trade.GetTimestamp() == 0 || i > 0 && trade.GetSequenceNumber() <= trades[i-1].GetSequenceNumber() {
+			t.Errorf("trade %d is not stamped in sequence, %v", i, trade)
+		}
+	}
+
+	addOrder(order(LimitBidOrder(5, 0, 1, 99, GoodTillCancel)))
+	trades = addOrder(order(LimitAskOrder(6, 0, 1, 99, GoodTillCancel)))
+	if len(trades) != 1 || trades[0].GetAggressorSide() != Ask || trades[0].GetAggressorOrderId() != 6 || trades[0].GetPassiveOrderId() != 5 {
+		t.Fatalf("expected ask 6 to take bid 5, got %v", trades)
+	}
+	// Stamped from the book's sequence, which moves on with the deletes that follow
+	if trades[0].GetSequenceNumber() > orderBook.GetSequenceNumber() {
+		t.Errorf("trade sequence %d is ahead of the book at %d", trades[0].GetSequenceNumber(), orderBook.GetSequenceNumber())
+	}
+}
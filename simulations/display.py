@@ -146,10 +146,11 @@ class OrderBookWindow(QMainWindow):
         self.best_bid = QLabel("Best Bid: --")
         self.best_ask = QLabel("Best Ask: --")
         self.spread = QLabel("Spread: --")
+        self.feed_status = QLabel("Feed: --")
 
         # Add metrics to right layout
         metrics_layout.addWidget(metrics_label)
-        for label in [self.last_price, self.best_bid, self.best_ask, self.spread]:
+        for label in [self.last_price, self.best_bid, self.best_ask, self.spread, self.feed_status]:
             label.setAlignment(Qt.AlignmentFlag.AlignLeft)
             metrics_layout.addWidget(label)
         
@@ -184,6 +185,7 @@ class OrderBookWindow(QMainWindow):
         self.best_bid.setText(f"Best Bid: {state.bestBid}")
         self.best_ask.setText(f"Best Ask: {state.bestAsk}")
         self.spread.setText(f"Spread: {state.spread}")
+        self.feed_status.setText("Feed: STALE" if state.stale else "Feed: LIVE")
 
 if __name__ == '__main__':
     app = QApplication(sys.argv)
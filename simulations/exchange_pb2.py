@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0e\x65xchange.proto\x12\x08\x65xchange\"\xec\x02\n\x0cOrderMessage\x12\"\n\x07\x63ommand\x18\x01 \x01(\x0e\x32\x11.exchange.Command\x12&\n\torderType\x18\x02 \x01(\x0e\x32\x13.exchange.OrderType\x12!\n\torderSide\x18\x03 \x01(\x0e\x32\x0e.exchange.Side\x12\x34\n\x10orderTimeInForce\x18\x04 \x01(\x0e\x32\x1a.exchange.OrderTimeInForce\x12\n\n\x02id\x18\x05 \x01(\x04\x12\x10\n\x08symbolId\x18\x06 \x01(\x04\x12\r\n\x05price\x18\x07 \x01(\x04\x12\x11\n\tstopPrice\x18\x08 \x01(\x04\x12\x16\n\x0etrailingAmount\x18\t \x01(\x04\x12\x19\n\x11lastExecutedPrice\x18\n \x01(\x04\x12\x10\n\x08quantity\x18\x0b \x01(\x04\x12\x14\n\x0copenQuantity\x18\x0c \x01(\x04\x12\x1c\n\x14lastExecutedQuantity\x18\r \x01(\x04\".\n\x14OrderResponseMessage\x12\x16\n\x0e\x65xchangeStatus\x18\x01 \x01(\t\"$\n\x10SubscribeRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\"(\n\x05Level\x12\r\n\x05price\x18\x01 \x01(\x04\x12\x10\n\x08quantity\x18\x02 \x01(\x04\"\xae\x01\n\x0eOrderBookState\x12\x1d\n\x04\x62ids\x18\x01 \x03(\x0b\x32\x0f.exchange.Level\x12\x1d\n\x04\x61sks\x18\x02 \x03(\x0b\x32\x0f.exchange.Level\x12\x19\n\x11lastExecutedPrice\x18\x03 \x01(\x04\x12\x0f\n\x07\x62\x65stBid\x18\x04 \x01(\x04\x12\x0f\n\x07\x62\x65stAsk\x18\x05 \x01(\x04\x12\x0e\n\x06spread\x18\x06 \x01(\x04\x12\x11\n\ttimestamp\x18\x07 \x01(\x03*7\n\x07\x43ommand\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\n\n\x06\x44\x45LETE\x10\x01\x12\x0b\n\x07REPLACE\x10\x02\x12\n\n\x06\x43\x41NCEL\x10\x03*h\n\tOrderType\x12\t\n\x05LIMIT\x10\x00\x12\n\n\x06MARKET\x10\x01\x12\x08\n\x04STOP\x10\x02\x12\x0e\n\nSTOP_LIMIT\x10\x03\x12\x11\n\rTRAILING_STOP\x10\x04\x12\x17\n\x13TRAILING_STOP_LIMIT\x10\x05*-\n\x10OrderTimeInForce\x12\x07\n\x03GTC\x10\x00\x12\x07\n\x03IOC\x10\x01\x12\x07\n\x03\x46OK\x10\x02*\x18\n\x04Side\x12\x07\n\x03\x42ID\x10\x00\x12\x07\n\x03\x41SK\x10\x01\x32\xac\x01\n\x0f\x45xchangeService\x12G\n\x0bHandleOrder\x12\x16.exchange.OrderMessage\x1a\x1e.exchange.OrderResponseMessage\"\x00\x12P\n\x14SubscribeToOrderBook\x12\x1a.exchange.SubscribeRequest\x1a\x18.exchange.OrderBookState\"\x00\x30\x01\x42\x0cZ\n./exchangeb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0e\x65xchange.proto\x12\x08\x65xchange\"\xea\x04\n\x0cOrderMessage\x12\"\n\x07\x63ommand\x18\x01 \x01(\x0e\x32\x11.exchange.Command\x12&\n\torderType\x18\x02 \x01(\x0e\x32\x13.exchange.OrderType\x12!\n\torderSide\x18\x03 \x01(\x0e\x32\x0e.exchange.Side\x12\x34\n\x10orderTimeInForce\x18\x04 \x01(\x0e\x32\x1a.exchange.OrderTimeInForce\x12\n\n\x02id\x18\x05 \x01(\x04\x12\x10\n\x08symbolId\x18\x06 \x01(\x04\x12\r\n\x05price\x18\x07 \x01(\x04\x12\x11\n\tstopPrice\x18\x08 \x01(\x04\x12\x16\n\x0etrailingAmount\x18\t \x01(\x04\x12\x19\n\x11lastExecutedPrice\x18\n \x01(\x04\x12\x10\n\x08quantity\x18\x0b \x01(\x04\x12\x14\n\x0copenQuantity\x18\x0c \x01(\x04\x12\x1c\n\x14lastExecutedQuantity\x18\r \x01(\x04\x12\r\n\x05newId\x18\x0e \x01(\x04\x12\x10\n\x08\x63lientId\x18\x0f \x01(\t\x12\x17\n\x0f\x64isplayQuantity\x18\x10 \x01(\x04\x12$\n\x08postOnly\x18\x11 \x01(\x0e\x32\x12.exchange.PostOnly\x12\x15\n\rparticipantId\x18\x12 \x01(\x04\x12:\n\x13selfTradePrevention\x18\x13 \x01(\x0e\x32\x1d.exchange.SelfTradePrevention\x12\x12\n\nexpireTime\x18\x14 \x01(\x03\x12\"\n\x07pegType\x18\x15 \x01(\x0e\x32\x11.exchange.PegType\x12\x11\n\tpegOffset\x18\x16 \x01(\x12\"^\n\x04\x46ill\x12\x0f\n\x07tradeId\x18\x01 \x01(\x04\x12\r\n\x05price\x18\x02 \x01(\x04\x12\x10\n\x08quantity\x18\x03 \x01(\x04\x12\x11\n\taggressor\x18\x04 \x01(\x08\x12\x11\n\ttimestamp\x18\x05 \x01(\x03\"\xb1\x02\n\x0f\x45xecutionReport\x12\x0f\n\x07orderId\x18\x01 \x01(\x04\x12\x10\n\x08symbolId\x18\x02 \x01(\x04\x12%\n\x06status\x18\x03 \x01(\x0e\x32\x15.exchange.OrderStatus\x12\x1a\n\x12\x63umulativeQuantity\x18\x04 \x01(\x04\x12\x16\n\x0eleavesQuantity\x18\x05 \x01(\x04\x12\x14\n\x0c\x61veragePrice\x18\x06 \x01(\x01\x12\x1d\n\x05\x66ills\x18\x07 \x03(\x0b\x32\x0e.exchange.Fill\x12\x14\n\x0crejectReason\x18\x08 \x01(\t\x12.\n\rexecutionType\x18\t \x01(\x0e\x32\x17.exchange.ExecutionType\x12\x10\n\x08\x63lientId\x18\n \x01(\t\x12\x13\n\x0borigOrderId\x18\x0b \x01(\x04\"-\n\x19\x45xecutionSubscribeRequest\x12\x10\n\x08\x63lientId\x18\x01 \x01(\t\"$\n\x10SubscribeRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\"(\n\x05Level\x12\r\n\x05price\x18\x01 \x01(\x04\x12\x10\n\x08quantity\x18\x02 \x01(\x04\"\xff\x01\n\x0eOrderBookState\x12\x1d\n\x04\x62ids\x18\x01 \x03(\x0b\x32\x0f.exchange.Level\x12\x1d\n\x04\x61sks\x18\x02 \x03(\x0b\x32\x0f.exchange.Level\x12\x19\n\x11lastExecutedPrice\x18\x03 \x01(\x04\x12\x0f\n\x07\x62\x65stBid\x18\x04 \x01(\x04\x12\x0f\n\x07\x62\x65stAsk\x18\x05 \x01(\x04\x12\x0e\n\x06spread\x18\x06 \x01(\x04\x12\x11\n\ttimestamp\x18\x07 \x01(\x03\x12\r\n\x05stale\x18\x08 \x01(\x08\x12,\n\x0csessionState\x18\t \x01(\x0e\x32\x16.exchange.SessionState\x12\x12\n\npriceScale\x18\n \x01(\r\"y\n\x0bLevelUpdate\x12+\n\x06\x61\x63tion\x18\x01 \x01(\x0e\x32\x1b.exchange.LevelUpdateAction\x12\x1c\n\x04side\x18\x02 \x01(\x0e\x32\x0e.exchange.Side\x12\r\n\x05price\x18\x03 \x01(\x04\x12\x10\n\x08quantity\x18\x04 \x01(\x04\"f\n\x0bTradeUpdate\x12\x0f\n\x07tradeId\x18\x01 \x01(\x04\x12\r\n\x05price\x18\x02 \x01(\x04\x12\x10\n\x08quantity\x18\x03 \x01(\x04\x12%\n\raggressorSide\x18\x04 \x01(\x0e\x32\x0e.exchange.Side\"\xa5\x01\n\x0bOrderUpdate\x12+\n\x06\x61\x63tion\x18\x01 \x01(\x0e\x32\x1b.exchange.OrderUpdateAction\x12\x0f\n\x07orderId\x18\x02 \x01(\x04\x12\x1c\n\x04side\x18\x03 \x01(\x0e\x32\x0e.exchange.Side\x12\r\n\x05price\x18\x04 \x01(\x04\x12\x10\n\x08quantity\x18\x05 \x01(\x04\x12\x19\n\x11remainingQuantity\x18\x06 \x01(\x04\"W\n\x0cRestingOrder\x12\x0f\n\x07orderId\x18\x01 \x01(\x04\x12\r\n\x05price\x18\x02 \x01(\x04\x12\x10\n\x08quantity\x18\x03 \x01(\x04\x12\x15\n\rqueuePosition\x18\x04 \x01(\r\"\xc0\x01\n\x15MarketByOrderSnapshot\x12$\n\x04\x62ids\x18\x01 \x03(\x0b\x32\x16.exchange.RestingOrder\x12$\n\x04\x61sks\x18\x02 \x03(\x0b\x32\x16.exchange.RestingOrder\x12\x19\n\x11lastExecutedPrice\x18\x03 \x01(\x04\x12,\n\x0csessionState\x18\x04 \x01(\x0e\x32\x16.exchange.SessionState\x12\x12\n\npriceScale\x18\x05 \x01(\r\"I\n\x0cPacketHeader\x12\x0f\n\x07\x63hannel\x18\x01 \x01(\x04\x12\x10\n\x08symbolId\x18\x02 \x01(\x04\x12\x16\n\x0esequenceNumber\x18\x03 \x01(\x04\"\x84\x01\n\rAuctionUpdate\x12\x17\n\x0findicativePrice\x18\x01 \x01(\x04\x12\x18\n\x10indicativeVolume\x18\x02 \x01(\x04\x12\x19\n\x11imbalanceQuantity\x18\x03 \x01(\x04\x12%\n\rimbalanceSide\x18\x04 \x01(\x0e\x32\x0e.exchange.Side\"6\n\rSessionStatus\x12%\n\x05state\x18\x01 \x01(\x0e\x32\x16.exchange.SessionState\"\xae\x03\n\x11MarketDataMessage\x12&\n\x06header\x18\x01 \x01(\x0b\x32\x16.exchange.PacketHeader\x12\x11\n\ttimestamp\x18\x02 \x01(\x03\x12,\n\x08snapshot\x18\x03 \x01(\x0b\x32\x18.exchange.OrderBookStateH\x00\x12,\n\x0blevelUpdate\x18\x04 \x01(\x0b\x32\x15.exchange.LevelUpdateH\x00\x12&\n\x05trade\x18\x05 \x01(\x0b\x32\x15.exchange.TradeUpdateH\x00\x12,\n\x0borderUpdate\x18\x06 \x01(\x0b\x32\x15.exchange.OrderUpdateH\x00\x12@\n\x15marketByOrderSnapshot\x18\x07 \x01(\x0b\x32\x1f.exchange.MarketByOrderSnapshotH\x00\x12\x30\n\rauctionUpdate\x18\x08 \x01(\x0b\x32\x17.exchange.AuctionUpdateH\x00\x12\x30\n\rsessionStatus\x18\t \x01(\x0b\x32\x17.exchange.SessionStatusH\x00\x42\x06\n\x04\x62ody\"[\n\x11RetransmitRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12\x1a\n\x12\x66romSequenceNumber\x18\x02 \x01(\x04\x12\x18\n\x10toSequenceNumber\x18\x03 \x01(\x04\"C\n\x12RetransmitResponse\x12-\n\x08messages\x18\x01 \x03(\x0b\x32\x1b.exchange.MarketDataMessage\"#\n\x0fSnapshotRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\"t\n\x11OrderGroupMessage\x12\x0f\n\x07groupId\x18\x01 \x01(\x04\x12&\n\x04type\x18\x02 \x01(\x0e\x32\x18.exchange.OrderGroupType\x12&\n\x06orders\x18\x03 \x03(\x0b\x32\x16.exchange.OrderMessage\"\x87\x01\n\x10OrderGroupStatus\x12\x0f\n\x07groupId\x18\x01 \x01(\x04\x12&\n\x04type\x18\x02 \x01(\x0e\x32\x18.exchange.OrderGroupType\x12(\n\x05state\x18\x03 \x01(\x0e\x32\x19.exchange.OrderGroupState\x12\x10\n\x08orderIds\x18\x04 \x03(\x04\"i\n\x10OrderGroupReport\x12)\n\x05group\x18\x01 \x01(\x0b\x32\x1a.exchange.OrderGroupStatus\x12*\n\x07reports\x18\x02 \x03(\x0b\x32\x19.exchange.ExecutionReport\"7\n\x12OrderStatusRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12\x0f\n\x07orderId\x18\x02 \x01(\x04\"k\n\x13OrderStatusResponse\x12)\n\x06report\x18\x01 \x01(\x0b\x32\x19.exchange.ExecutionReport\x12)\n\x05group\x18\x02 \x01(\x0b\x32\x1a.exchange.OrderGroupStatus\"!\n\rSymbolRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\"N\n\x13SessionStateRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12%\n\x05state\x18\x02 \x01(\x0e\x32\x16.exchange.SessionState\"\xd2\x01\n\nSymbolInfo\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12\x0e\n\x06ticker\x18\x02 \x01(\t\x12,\n\x0csessionState\x18\x03 \x01(\x0e\x32\x16.exchange.SessionState\x12\x10\n\x08tickSize\x18\x04 \x01(\x04\x12\x0f\n\x07lotSize\x18\x05 \x01(\x04\x12\x13\n\x0bminQuantity\x18\x06 \x01(\x04\x12\x13\n\x0bmaxQuantity\x18\x07 \x01(\x04\x12\x13\n\x0bmaxNotional\x18\x08 \x01(\x04\x12\x12\n\npriceScale\x18\t \x01(\r\"\x14\n\x12ListSymbolsRequest\"3\n\nSymbolList\x12%\n\x07symbols\x18\x01 \x03(\x0b\x32\x14.exchange.SymbolInfo\"\x7f\n\x11MassCancelRequest\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12\x12\n\nallSymbols\x18\x02 \x01(\x08\x12\x15\n\rparticipantId\x18\x03 \x01(\x04\x12\x0f\n\x07oneSide\x18\x04 \x01(\x08\x12\x1c\n\x04side\x18\x05 \x01(\x0e\x32\x0e.exchange.Side\"@\n\x12MassCancelResponse\x12*\n\x07reports\x18\x01 \x03(\x0b\x32\x19.exchange.ExecutionReport\"?\n\rOrderBookDump\x12\x10\n\x08symbolId\x18\x01 \x01(\x04\x12\x0e\n\x06ticker\x18\x02 \x01(\t\x12\x0c\n\x04\x62ook\x18\x03 \x01(\t*C\n\x07\x43ommand\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\n\n\x06\x44\x45LETE\x10\x01\x12\x0b\n\x07REPLACE\x10\x02\x12\n\n\x06\x43\x41NCEL\x10\x03\x12\n\n\x06MODIFY\x10\x04*h\n\tOrderType\x12\t\n\x05LIMIT\x10\x00\x12\n\n\x06MARKET\x10\x01\x12\x08\n\x04STOP\x10\x02\x12\x0e\n\nSTOP_LIMIT\x10\x03\x12\x11\n\rTRAILING_STOP\x10\x04\x12\x17\n\x13TRAILING_STOP_LIMIT\x10\x05*?\n\x10OrderTimeInForce\x12\x07\n\x03GTC\x10\x00\x12\x07\n\x03IOC\x10\x01\x12\x07\n\x03\x46OK\x10\x02\x12\x07\n\x03\x44\x41Y\x10\x03\x12\x07\n\x03GTD\x10\x04*J\n\x08PostOnly\x12\x11\n\rPOST_ONLY_OFF\x10\x00\x12\x14\n\x10POST_ONLY_REJECT\x10\x01\x12\x15\n\x11POST_ONLY_REPRICE\x10\x02*I\n\x07PegType\x12\x0b\n\x07PEG_OFF\x10\x00\x12\x0f\n\x0bPEG_PRIMARY\x10\x01\x12\x0e\n\nPEG_MARKET\x10\x02\x12\x10\n\x0cPEG_MIDPOINT\x10\x03*\x87\x01\n\x13SelfTradePrevention\x12\x0b\n\x07STP_OFF\x10\x00\x12\x16\n\x12STP_CANCEL_RESTING\x10\x01\x12\x18\n\x14STP_CANCEL_AGGRESSOR\x10\x02\x12\x13\n\x0fSTP_CANCEL_BOTH\x10\x03\x12\x1c\n\x18STP_DECREMENT_AND_CANCEL\x10\x04*\xbe\x01\n\x0cSessionState\x12\x16\n\x12SESSION_CONTINUOUS\x10\x00\x12\x14\n\x10SESSION_PRE_OPEN\x10\x01\x12\x1b\n\x17SESSION_OPENING_AUCTION\x10\x02\x12\x12\n\x0eSESSION_HALTED\x10\x03\x12\x1b\n\x17SESSION_CLOSING_AUCTION\x10\x04\x12\x12\n\x0eSESSION_CLOSED\x10\x05\x12\x1e\n\x1aSESSION_VOLATILITY_AUCTION\x10\x06*\x18\n\x04Side\x12\x07\n\x03\x42ID\x10\x00\x12\x07\n\x03\x41SK\x10\x01*f\n\x0bOrderStatus\x12\x07\n\x03NEW\x10\x00\x12\x14\n\x10PARTIALLY_FILLED\x10\x01\x12\n\n\x06\x46ILLED\x10\x02\x12\r\n\tCANCELLED\x10\x03\x12\x0c\n\x08REJECTED\x10\x04\x12\x0f\n\x0bPENDING_NEW\x10\x05*i\n\rExecutionType\x12\x10\n\x0c\x41\x43KNOWLEDGED\x10\x00\x12\t\n\x05TRADE\x10\x01\x12\x10\n\x0c\x43\x41NCELLATION\x10\x02\x12\r\n\tTRIGGERED\x10\x03\x12\x0c\n\x08RESTATED\x10\x04\x12\x0c\n\x08REPLACED\x10\x05*F\n\x11LevelUpdateAction\x12\r\n\tLEVEL_ADD\x10\x00\x12\x10\n\x0cLEVEL_MODIFY\x10\x01\x12\x10\n\x0cLEVEL_DELETE\x10\x02*G\n\x11OrderUpdateAction\x12\r\n\tORDER_ADD\x10\x00\x12\x11\n\rORDER_EXECUTE\x10\x01\x12\x10\n\x0cORDER_CANCEL\x10\x02*/\n\x0eOrderGroupType\x12\x07\n\x03OCO\x10\x00\x12\x07\n\x03OTO\x10\x01\x12\x0b\n\x07\x42RACKET\x10\x02*`\n\x0fOrderGroupState\x12\x11\n\rGROUP_PENDING\x10\x00\x12\x10\n\x0cGROUP_ACTIVE\x10\x01\x12\x13\n\x0fGROUP_COMPLETED\x10\x02\x12\x13\n\x0fGROUP_CANCELLED\x10\x03\x32\x88\x05\n\x0f\x45xchangeService\x12\x42\n\x0bHandleOrder\x12\x16.exchange.OrderMessage\x1a\x19.exchange.ExecutionReport\"\x00\x12P\n\x14SubscribeToOrderBook\x12\x1a.exchange.SubscribeRequest\x1a\x18.exchange.OrderBookState\"\x00\x30\x01\x12[\n\x15SubscribeToExecutions\x12#.exchange.ExecutionSubscribeRequest\x1a\x19.exchange.ExecutionReport\"\x00\x30\x01\x12I\n\nRetransmit\x12\x1b.exchange.RetransmitRequest\x1a\x1c.exchange.RetransmitResponse\"\x00\x12G\n\x0bGetSnapshot\x12\x19.exchange.SnapshotRequest\x1a\x1b.exchange.MarketDataMessage\"\x00\x12M\n\x10HandleOrderGroup\x12\x1b.exchange.OrderGroupMessage\x1a\x1a.exchange.OrderGroupReport\"\x00\x12O\n\x0eGetOrderStatus\x12\x1c.exchange.OrderStatusRequest\x1a\x1d.exchange.OrderStatusResponse\"\x00\x12N\n\x0f\x43\x61ncelOwnOrders\x12\x1b.exchange.MassCancelRequest\x1a\x1c.exchange.MassCancelResponse\"\x00\x32\x87\x05\n\x0c\x41\x64minService\x12K\n\x0fSetSessionState\x12\x1d.exchange.SessionStateRequest\x1a\x17.exchange.SessionStatus\"\x00\x12\x45\n\x0fGetSessionState\x12\x17.exchange.SymbolRequest\x1a\x17.exchange.SessionStatus\"\x00\x12\x43\n\x0bListSymbols\x12\x1c.exchange.ListSymbolsRequest\x1a\x14.exchange.SymbolList\"\x00\x12<\n\x0c\x41\x64\x64OrderBook\x12\x14.exchange.SymbolInfo\x1a\x14.exchange.SymbolInfo\"\x00\x12J\n\x0f\x44\x65leteOrderBook\x12\x17.exchange.SymbolRequest\x1a\x1c.exchange.MassCancelResponse\"\x00\x12@\n\nHaltSymbol\x12\x17.exchange.SymbolRequest\x1a\x17.exchange.SessionStatus\"\x00\x12\x42\n\x0cResumeSymbol\x12\x17.exchange.SymbolRequest\x1a\x17.exchange.SessionStatus\"\x00\x12I\n\nMassCancel\x12\x1b.exchange.MassCancelRequest\x1a\x1c.exchange.MassCancelResponse\"\x00\x12\x43\n\rDumpOrderBook\x12\x17.exchange.SymbolRequest\x1a\x17.exchange.OrderBookDump\"\x00\x42\x0cZ\n./exchangeb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,24 +32,100 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'exchange_pb2', _globals)
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\n./exchange'
-  _globals['_COMMAND']._serialized_start=700
-  _globals['_COMMAND']._serialized_end=755
-  _globals['_ORDERTYPE']._serialized_start=757
-  _globals['_ORDERTYPE']._serialized_end=861
-  _globals['_ORDERTIMEINFORCE']._serialized_start=863
-  _globals['_ORDERTIMEINFORCE']._serialized_end=908
-  _globals['_SIDE']._serialized_start=910
-  _globals['_SIDE']._serialized_end=934
+  _globals['_COMMAND']._serialized_start=4207
+  _globals['_COMMAND']._serialized_end=4274
+  _globals['_ORDERTYPE']._serialized_start=4276
+  _globals['_ORDERTYPE']._serialized_end=4380
+  _globals['_ORDERTIMEINFORCE']._serialized_start=4382
+  _globals['_ORDERTIMEINFORCE']._serialized_end=4445
+  _globals['_POSTONLY']._serialized_start=4447
+  _globals['_POSTONLY']._serialized_end=4521
+  _globals['_PEGTYPE']._serialized_start=4523
+  _globals['_PEGTYPE']._serialized_end=4596
+  _globals['_SELFTRADEPREVENTION']._serialized_start=4599
+  _globals['_SELFTRADEPREVENTION']._serialized_end=4734
+  _globals['_SESSIONSTATE']._serialized_start=4737
+  _globals['_SESSIONSTATE']._serialized_end=4927
+  _globals['_SIDE']._serialized_start=4929
+  _globals['_SIDE']._serialized_end=4953
+  _globals['_ORDERSTATUS']._serialized_start=4955
+  _globals['_ORDERSTATUS']._serialized_end=5057
+  _globals['_EXECUTIONTYPE']._serialized_start=5059
+  _globals['_EXECUTIONTYPE']._serialized_end=5164
+  _globals['_LEVELUPDATEACTION']._serialized_start=5166
+  _globals['_LEVELUPDATEACTION']._serialized_end=5236
+  _globals['_ORDERUPDATEACTION']._serialized_start=5238
+  _globals['_ORDERUPDATEACTION']._serialized_end=5309
+  _globals['_ORDERGROUPTYPE']._serialized_start=5311
+  _globals['_ORDERGROUPTYPE']._serialized_end=5358
+  _globals['_ORDERGROUPSTATE']._serialized_start=5360
+  _globals['_ORDERGROUPSTATE']._serialized_end=5456
   _globals['_ORDERMESSAGE']._serialized_start=29
-  _globals['_ORDERMESSAGE']._serialized_end=393
-  _globals['_ORDERRESPONSEMESSAGE']._serialized_start=395
-  _globals['_ORDERRESPONSEMESSAGE']._serialized_end=441
-  _globals['_SUBSCRIBEREQUEST']._serialized_start=443
-  _globals['_SUBSCRIBEREQUEST']._serialized_end=479
-  _globals['_LEVEL']._serialized_start=481
-  _globals['_LEVEL']._serialized_end=521
-  _globals['_ORDERBOOKSTATE']._serialized_start=524
-  _globals['_ORDERBOOKSTATE']._serialized_end=698
-  _globals['_EXCHANGESERVICE']._serialized_start=937
-  _globals['_EXCHANGESERVICE']._serialized_end=1109
+  _globals['_ORDERMESSAGE']._serialized_end=647
+  _globals['_FILL']._serialized_start=649
+  _globals['_FILL']._serialized_end=743
+  _globals['_EXECUTIONREPORT']._serialized_start=746
+  _globals['_EXECUTIONREPORT']._serialized_end=1051
+  _globals['_EXECUTIONSUBSCRIBEREQUEST']._serialized_start=1053
+  _globals['_EXECUTIONSUBSCRIBEREQUEST']._serialized_end=1098
+  _globals['_SUBSCRIBEREQUEST']._serialized_start=1100
+  _globals['_SUBSCRIBEREQUEST']._serialized_end=1136
+  _globals['_LEVEL']._serialized_start=1138
+  _globals['_LEVEL']._serialized_end=1178
+  _globals['_ORDERBOOKSTATE']._serialized_start=1181
+  _globals['_ORDERBOOKSTATE']._serialized_end=1436
+  _globals['_LEVELUPDATE']._serialized_start=1438
+  _globals['_LEVELUPDATE']._serialized_end=1559
+  _globals['_TRADEUPDATE']._serialized_start=1561
+  _globals['_TRADEUPDATE']._serialized_end=1663
+  _globals['_ORDERUPDATE']._serialized_start=1666
+  _globals['_ORDERUPDATE']._serialized_end=1831
+  _globals['_RESTINGORDER']._serialized_start=1833
+  _globals['_RESTINGORDER']._serialized_end=1920
+  _globals['_MARKETBYORDERSNAPSHOT']._serialized_start=1923
+  _globals['_MARKETBYORDERSNAPSHOT']._serialized_end=2115
+  _globals['_PACKETHEADER']._serialized_start=2117
+  _globals['_PACKETHEADER']._serialized_end=2190
+  _globals['_AUCTIONUPDATE']._serialized_start=2193
+  _globals['_AUCTIONUPDATE']._serialized_end=2325
+  _globals['_SESSIONSTATUS']._serialized_start=2327
+  _globals['_SESSIONSTATUS']._serialized_end=2381
+  _globals['_MARKETDATAMESSAGE']._serialized_start=2384
+  _globals['_MARKETDATAMESSAGE']._serialized_end=2814
+  _globals['_RETRANSMITREQUEST']._serialized_start=2816
+  _globals['_RETRANSMITREQUEST']._serialized_end=2907
+  _globals['_RETRANSMITRESPONSE']._serialized_start=2909
+  _globals['_RETRANSMITRESPONSE']._serialized_end=2976
+  _globals['_SNAPSHOTREQUEST']._serialized_start=2978
+  _globals['_SNAPSHOTREQUEST']._serialized_end=3013
+  _globals['_ORDERGROUPMESSAGE']._serialized_start=3015
+  _globals['_ORDERGROUPMESSAGE']._serialized_end=3131
+  _globals['_ORDERGROUPSTATUS']._serialized_start=3134
+  _globals['_ORDERGROUPSTATUS']._serialized_end=3269
+  _globals['_ORDERGROUPREPORT']._serialized_start=3271
+  _globals['_ORDERGROUPREPORT']._serialized_end=3376
+  _globals['_ORDERSTATUSREQUEST']._serialized_start=3378
+  _globals['_ORDERSTATUSREQUEST']._serialized_end=3433
+  _globals['_ORDERSTATUSRESPONSE']._serialized_start=3435
+  _globals['_ORDERSTATUSRESPONSE']._serialized_end=3542
+  _globals['_SYMBOLREQUEST']._serialized_start=3544
+  _globals['_SYMBOLREQUEST']._serialized_end=3577
+  _globals['_SESSIONSTATEREQUEST']._serialized_start=3579
+  _globals['_SESSIONSTATEREQUEST']._serialized_end=3657
+  _globals['_SYMBOLINFO']._serialized_start=3660
+  _globals['_SYMBOLINFO']._serialized_end=3870
+  _globals['_LISTSYMBOLSREQUEST']._serialized_start=3872
+  _globals['_LISTSYMBOLSREQUEST']._serialized_end=3892
+  _globals['_SYMBOLLIST']._serialized_start=3894
+  _globals['_SYMBOLLIST']._serialized_end=3945
+  _globals['_MASSCANCELREQUEST']._serialized_start=3947
+  _globals['_MASSCANCELREQUEST']._serialized_end=4074
+  _globals['_MASSCANCELRESPONSE']._serialized_start=4076
+  _globals['_MASSCANCELRESPONSE']._serialized_end=4140
+  _globals['_ORDERBOOKDUMP']._serialized_start=4142
+  _globals['_ORDERBOOKDUMP']._serialized_end=4205
+  _globals['_EXCHANGESERVICE']._serialized_start=5459
+  _globals['_EXCHANGESERVICE']._serialized_end=6107
+  _globals['_ADMINSERVICE']._serialized_start=6110
+  _globals['_ADMINSERVICE']._serialized_end=6757
 # @@protoc_insertion_point(module_scope)
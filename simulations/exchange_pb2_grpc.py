@@ -37,13 +37,43 @@ class ExchangeServiceStub(object):
         self.HandleOrder = channel.unary_unary(
                 '/exchange.ExchangeService/HandleOrder',
                 request_serializer=exchange__pb2.OrderMessage.SerializeToString,
-                response_deserializer=exchange__pb2.OrderResponseMessage.FromString,
+                response_deserializer=exchange__pb2.ExecutionReport.FromString,
                 _registered_method=True)
         self.SubscribeToOrderBook = channel.unary_stream(
                 '/exchange.ExchangeService/SubscribeToOrderBook',
                 request_serializer=exchange__pb2.SubscribeRequest.SerializeToString,
                 response_deserializer=exchange__pb2.OrderBookState.FromString,
                 _registered_method=True)
+        self.SubscribeToExecutions = channel.unary_stream(
+                '/exchange.ExchangeService/SubscribeToExecutions',
+                request_serializer=exchange__pb2.ExecutionSubscribeRequest.SerializeToString,
+                response_deserializer=exchange__pb2.ExecutionReport.FromString,
+                _registered_method=True)
+        self.Retransmit = channel.unary_unary(
+                '/exchange.ExchangeService/Retransmit',
+                request_serializer=exchange__pb2.RetransmitRequest.SerializeToString,
+                response_deserializer=exchange__pb2.RetransmitResponse.FromString,
+                _registered_method=True)
+        self.GetSnapshot = channel.unary_unary(
+                '/exchange.ExchangeService/GetSnapshot',
+                request_serializer=exchange__pb2.SnapshotRequest.SerializeToString,
+                response_deserializer=exchange__pb2.MarketDataMessage.FromString,
+                _registered_method=True)
+        self.HandleOrderGroup = channel.unary_unary(
+                '/exchange.ExchangeService/HandleOrderGroup',
+                request_serializer=exchange__pb2.OrderGroupMessage.SerializeToString,
+                response_deserializer=exchange__pb2.OrderGroupReport.FromString,
+                _registered_method=True)
+        self.GetOrderStatus = channel.unary_unary(
+                '/exchange.ExchangeService/GetOrderStatus',
+                request_serializer=exchange__pb2.OrderStatusRequest.SerializeToString,
+                response_deserializer=exchange__pb2.OrderStatusResponse.FromString,
+                _registered_method=True)
+        self.CancelOwnOrders = channel.unary_unary(
+                '/exchange.ExchangeService/CancelOwnOrders',
+                request_serializer=exchange__pb2.MassCancelRequest.SerializeToString,
+                response_deserializer=exchange__pb2.MassCancelResponse.FromString,
+                _registered_method=True)
 
 
 class ExchangeServiceServicer(object):
@@ -61,19 +91,85 @@ class ExchangeServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SubscribeToExecutions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Retransmit(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSnapshot(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def HandleOrderGroup(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetOrderStatus(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CancelOwnOrders(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ExchangeServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
             'HandleOrder': grpc.unary_unary_rpc_method_handler(
                     servicer.HandleOrder,
                     request_deserializer=exchange__pb2.OrderMessage.FromString,
-                    response_serializer=exchange__pb2.OrderResponseMessage.SerializeToString,
+                    response_serializer=exchange__pb2.ExecutionReport.SerializeToString,
             ),
             'SubscribeToOrderBook': grpc.unary_stream_rpc_method_handler(
                     servicer.SubscribeToOrderBook,
                     request_deserializer=exchange__pb2.SubscribeRequest.FromString,
                     response_serializer=exchange__pb2.OrderBookState.SerializeToString,
             ),
+            'SubscribeToExecutions': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeToExecutions,
+                    request_deserializer=exchange__pb2.ExecutionSubscribeRequest.FromString,
+                    response_serializer=exchange__pb2.ExecutionReport.SerializeToString,
+            ),
+            'Retransmit': grpc.unary_unary_rpc_method_handler(
+                    servicer.Retransmit,
+                    request_deserializer=exchange__pb2.RetransmitRequest.FromString,
+                    response_serializer=exchange__pb2.RetransmitResponse.SerializeToString,
+            ),
+            'GetSnapshot': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSnapshot,
+                    request_deserializer=exchange__pb2.SnapshotRequest.FromString,
+                    response_serializer=exchange__pb2.MarketDataMessage.SerializeToString,
+            ),
+            'HandleOrderGroup': grpc.unary_unary_rpc_method_handler(
+                    servicer.HandleOrderGroup,
+                    request_deserializer=exchange__pb2.OrderGroupMessage.FromString,
+                    response_serializer=exchange__pb2.OrderGroupReport.SerializeToString,
+            ),
+            'GetOrderStatus': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetOrderStatus,
+                    request_deserializer=exchange__pb2.OrderStatusRequest.FromString,
+                    response_serializer=exchange__pb2.OrderStatusResponse.SerializeToString,
+            ),
+            'CancelOwnOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.CancelOwnOrders,
+                    request_deserializer=exchange__pb2.MassCancelRequest.FromString,
+                    response_serializer=exchange__pb2.MassCancelResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'exchange.ExchangeService', rpc_method_handlers)
@@ -101,7 +197,7 @@ class ExchangeService(object):
             target,
             '/exchange.ExchangeService/HandleOrder',
             exchange__pb2.OrderMessage.SerializeToString,
-            exchange__pb2.OrderResponseMessage.FromString,
+            exchange__pb2.ExecutionReport.FromString,
             options,
             channel_credentials,
             insecure,
@@ -138,3 +234,580 @@ class ExchangeService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def SubscribeToExecutions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/exchange.ExchangeService/SubscribeToExecutions',
+            exchange__pb2.ExecutionSubscribeRequest.SerializeToString,
+            exchange__pb2.ExecutionReport.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Retransmit(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.ExchangeService/Retransmit',
+            exchange__pb2.RetransmitRequest.SerializeToString,
+            exchange__pb2.RetransmitResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetSnapshot(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.ExchangeService/GetSnapshot',
+            exchange__pb2.SnapshotRequest.SerializeToString,
+            exchange__pb2.MarketDataMessage.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def HandleOrderGroup(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.ExchangeService/HandleOrderGroup',
+            exchange__pb2.OrderGroupMessage.SerializeToString,
+            exchange__pb2.OrderGroupReport.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetOrderStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.ExchangeService/GetOrderStatus',
+            exchange__pb2.OrderStatusRequest.SerializeToString,
+            exchange__pb2.OrderStatusResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CancelOwnOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.ExchangeService/CancelOwnOrders',
+            exchange__pb2.MassCancelRequest.SerializeToString,
+            exchange__pb2.MassCancelResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+class AdminServiceStub(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.SetSessionState = channel.unary_unary(
+                '/exchange.AdminService/SetSessionState',
+                request_serializer=exchange__pb2.SessionStateRequest.SerializeToString,
+                response_deserializer=exchange__pb2.SessionStatus.FromString,
+                _registered_method=True)
+        self.GetSessionState = channel.unary_unary(
+                '/exchange.AdminService/GetSessionState',
+                request_serializer=exchange__pb2.SymbolRequest.SerializeToString,
+                response_deserializer=exchange__pb2.SessionStatus.FromString,
+                _registered_method=True)
+        self.ListSymbols = channel.unary_unary(
+                '/exchange.AdminService/ListSymbols',
+                request_serializer=exchange__pb2.ListSymbolsRequest.SerializeToString,
+                response_deserializer=exchange__pb2.SymbolList.FromString,
+                _registered_method=True)
+        self.AddOrderBook = channel.unary_unary(
+                '/exchange.AdminService/AddOrderBook',
+                request_serializer=exchange__pb2.SymbolInfo.SerializeToString,
+                response_deserializer=exchange__pb2.SymbolInfo.FromString,
+                _registered_method=True)
+        self.DeleteOrderBook = channel.unary_unary(
+                '/exchange.AdminService/DeleteOrderBook',
+                request_serializer=exchange__pb2.SymbolRequest.SerializeToString,
+                response_deserializer=exchange__pb2.MassCancelResponse.FromString,
+                _registered_method=True)
+        self.HaltSymbol = channel.unary_unary(
+                '/exchange.AdminService/HaltSymbol',
+                request_serializer=exchange__pb2.SymbolRequest.SerializeToString,
+                response_deserializer=exchange__pb2.SessionStatus.FromString,
+                _registered_method=True)
+        self.ResumeSymbol = channel.unary_unary(
+                '/exchange.AdminService/ResumeSymbol',
+                request_serializer=exchange__pb2.SymbolRequest.SerializeToString,
+                response_deserializer=exchange__pb2.SessionStatus.FromString,
+                _registered_method=True)
+        self.MassCancel = channel.unary_unary(
+                '/exchange.AdminService/MassCancel',
+                request_serializer=exchange__pb2.MassCancelRequest.SerializeToString,
+                response_deserializer=exchange__pb2.MassCancelResponse.FromString,
+                _registered_method=True)
+        self.DumpOrderBook = channel.unary_unary(
+                '/exchange.AdminService/DumpOrderBook',
+                request_serializer=exchange__pb2.SymbolRequest.SerializeToString,
+                response_deserializer=exchange__pb2.OrderBookDump.FromString,
+                _registered_method=True)
+
+
+class AdminServiceServicer(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def SetSessionState(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSessionState(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListSymbols(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AddOrderBook(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteOrderBook(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def HaltSymbol(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResumeSymbol(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MassCancel(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DumpOrderBook(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_AdminServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'SetSessionState': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetSessionState,
+                    request_deserializer=exchange__pb2.SessionStateRequest.FromString,
+                    response_serializer=exchange__pb2.SessionStatus.SerializeToString,
+            ),
+            'GetSessionState': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSessionState,
+                    request_deserializer=exchange__pb2.SymbolRequest.FromString,
+                    response_serializer=exchange__pb2.SessionStatus.SerializeToString,
+            ),
+            'ListSymbols': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListSymbols,
+                    request_deserializer=exchange__pb2.ListSymbolsRequest.FromString,
+                    response_serializer=exchange__pb2.SymbolList.SerializeToString,
+            ),
+            'AddOrderBook': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddOrderBook,
+                    request_deserializer=exchange__pb2.SymbolInfo.FromString,
+                    response_serializer=exchange__pb2.SymbolInfo.SerializeToString,
+            ),
+            'DeleteOrderBook': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteOrderBook,
+                    request_deserializer=exchange__pb2.SymbolRequest.FromString,
+                    response_serializer=exchange__pb2.MassCancelResponse.SerializeToString,
+            ),
+            'HaltSymbol': grpc.unary_unary_rpc_method_handler(
+                    servicer.HaltSymbol,
+                    request_deserializer=exchange__pb2.SymbolRequest.FromString,
+                    response_serializer=exchange__pb2.SessionStatus.SerializeToString,
+            ),
+            'ResumeSymbol': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResumeSymbol,
+                    request_deserializer=exchange__pb2.SymbolRequest.FromString,
+                    response_serializer=exchange__pb2.SessionStatus.SerializeToString,
+            ),
+            'MassCancel': grpc.unary_unary_rpc_method_handler(
+                    servicer.MassCancel,
+                    request_deserializer=exchange__pb2.MassCancelRequest.FromString,
+                    response_serializer=exchange__pb2.MassCancelResponse.SerializeToString,
+            ),
+            'DumpOrderBook': grpc.unary_unary_rpc_method_handler(
+                    servicer.DumpOrderBook,
+                    request_deserializer=exchange__pb2.SymbolRequest.FromString,
+                    response_serializer=exchange__pb2.OrderBookDump.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'exchange.AdminService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('exchange.AdminService', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class AdminService(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def SetSessionState(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/SetSessionState',
+            exchange__pb2.SessionStateRequest.SerializeToString,
+            exchange__pb2.SessionStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetSessionState(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/GetSessionState',
+            exchange__pb2.SymbolRequest.SerializeToString,
+            exchange__pb2.SessionStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListSymbols(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/ListSymbols',
+            exchange__pb2.ListSymbolsRequest.SerializeToString,
+            exchange__pb2.SymbolList.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AddOrderBook(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/AddOrderBook',
+            exchange__pb2.SymbolInfo.SerializeToString,
+            exchange__pb2.SymbolInfo.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteOrderBook(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/DeleteOrderBook',
+            exchange__pb2.SymbolRequest.SerializeToString,
+            exchange__pb2.MassCancelResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def HaltSymbol(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/HaltSymbol',
+            exchange__pb2.SymbolRequest.SerializeToString,
+            exchange__pb2.SessionStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ResumeSymbol(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/ResumeSymbol',
+            exchange__pb2.SymbolRequest.SerializeToString,
+            exchange__pb2.SessionStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MassCancel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/MassCancel',
+            exchange__pb2.MassCancelRequest.SerializeToString,
+            exchange__pb2.MassCancelResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DumpOrderBook(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/exchange.AdminService/DumpOrderBook',
+            exchange__pb2.SymbolRequest.SerializeToString,
+            exchange__pb2.OrderBookDump.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -4,7 +4,7 @@ import matplotlib.pyplot as plt
 from datetime import datetime, timedelta
 from dataclasses import dataclass
 from typing import List
-from exchange_pb2 import OrderMessage, Command, OrderResponseMessage, OrderTimeInForce, OrderType, Side
+from exchange_pb2 import OrderMessage, Command, ExecutionReport, OrderStatus, OrderTimeInForce, OrderType, Side
 from exchange_pb2_grpc import ExchangeServiceStub
 import sys
 import time
@@ -106,7 +106,7 @@ class RealtimeOrderGenerator:
                             response = self.stub.HandleOrder(order)
                             response_time = time.time() - send_time
                             print(f"Response time: {response_time} seconds")
-                            print(response.exchangeStatus)
+                            print(f"Order {response.orderId}: {OrderStatus.Name(response.status)}, filled {response.cumulativeQuantity} @ {response.averagePrice:.2f}, leaves {response.leavesQuantity}")
                         except grpc.RpcError as e:
                             print(f"Failed to send order: {e}")
                             